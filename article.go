@@ -1,13 +1,19 @@
 package client
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"time"
 
 	"github.com/0ffsideCompass/models"
 )
 
 const (
 	createArticleEndpoint = "/api/v1/articles"
+	articleEndpoint       = "/api/v1/articles/{id}"
 )
 
 // CreateArticle creates or updates an article in the Data Warehouse.
@@ -15,15 +21,385 @@ const (
 //
 // Parameters:
 //   - request: CreateArticleRequest containing the article details
+//   - opts: Optional per-call settings such as WithContext
 //
 // Returns:
 //   - *Article: The created or updated article
 //   - error: An error object that reports issues either in sending the request, handling the response, or parsing the JSON
-func (c *Client) CreateArticle(request models.DataWarehouseCreateArticleRequest) error {
-	_, err := c.post(createArticleEndpoint, request)
+func (c *Client) CreateArticle(request models.DataWarehouseCreateArticleRequest, opts ...RequestOption) error {
+	request = c.prepareArticleRequest(request)
+	if err := c.validateRequest(articleRequest, request); err != nil {
+		return fmt.Errorf("error creating article: %w", err)
+	}
+
+	_, err := c.post(context.Background(), c.endpoint(OperationCreateArticle), c.mapFields(request), append(opts, acceptStatus(http.StatusCreated), operation(OperationCreateArticle), validatedBody(articleRequest), bodyUnused())...)
 	if err != nil {
 		return fmt.Errorf("error creating article: %w", err)
 	}
 
 	return nil
 }
+
+// UpdateArticle replaces an existing article in the Data Warehouse.
+// Pass WithIfMatch or WithIfUnmodifiedSince to make the update conditional on the version the caller
+// last read; if the article has changed in the meantime a *ConflictError is returned so the caller can
+// re-read and retry.
+//
+// Parameters:
+//   - id: ID of the article to update
+//   - request: CreateArticleRequest containing the new article details
+//   - opts: Optional per-call settings such as preconditions
+//
+// Returns:
+//   - error: A *ConflictError if a precondition failed, otherwise an error reporting issues in sending the request or handling the response
+func (c *Client) UpdateArticle(id string, request models.DataWarehouseCreateArticleRequest, opts ...RequestOption) error {
+	if id == "" {
+		return errEmptyID
+	}
+
+	request = c.prepareArticleRequest(request)
+	if err := c.validateRequest(articleRequest, request); err != nil {
+		return fmt.Errorf("error updating article: %w", err)
+	}
+
+	_, err := c.put(context.Background(), withID(c.endpoint(OperationUpdateArticle), id), c.mapFields(request), append(opts, operation(OperationUpdateArticle), validatedBody(articleRequest))...)
+	if err != nil {
+		return fmt.Errorf("error updating article: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteArticle deletes the article with the given ID from the Data Warehouse.
+// Pass WithIfMatch with the ETag the caller last read to only delete that version; if the article has
+// changed in the meantime the Data Warehouse responds with 412 Precondition Failed and a *ConflictError
+// is returned, so a newer version is never deleted unseen. Without a precondition the article is
+// deleted unconditionally.
+//
+// Parameters:
+//   - id: ID of the article to delete
+//   - opts: Optional per-call settings such as WithIfMatch
+//
+// Returns:
+//   - error: A *NotFoundError if no article has the ID, a *ConflictError if a precondition failed, otherwise an error reporting issues in sending the request or handling the response
+func (c *Client) DeleteArticle(id string, opts ...RequestOption) error {
+	if id == "" {
+		return errEmptyID
+	}
+
+	_, err := c.delete(context.Background(), withID(c.endpoint(OperationDeleteArticle), id), append(opts, acceptStatus(http.StatusNoContent), operation(OperationDeleteArticle))...)
+	if err != nil {
+		return fmt.Errorf("error deleting article: %w", asNotFound(err, "article", id))
+	}
+
+	return nil
+}
+
+// ArticleResponse represents the API response for a single article.
+// Included holds the relations expanded with WithInclude, keyed by relation name, and is empty when
+// none were requested or the server does not support expansion.
+type ArticleResponse struct {
+	Article  *models.Article            `json:"article"`
+	Included map[string]json.RawMessage `json:"included,omitempty"`
+}
+
+// GetArticle retrieves the article with the given ID.
+// A 204 No Content response, which some deployments send for articles that exist but have no body
+// representation, is reported as ErrNoContent rather than as a parse failure. Pass
+// WithReadAfterWriteRetry to tolerate replication lag when reading back a new article, and WithFields
+// to retrieve only some fields.
+//
+// Parameters:
+//   - id: ID of the article to retrieve
+//   - opts: Optional per-call settings such as WithContext
+//
+// Returns:
+//   - *models.Article: The article
+//   - error: A *NotFoundError if no article has the ID, ErrNoContent if the server answers 204 No Content, an *EmptyEntityError if the response carries no article, otherwise an error reporting issues in sending the request, handling the response, or parsing the JSON
+func (c *Client) GetArticle(id string, opts ...RequestOption) (*models.Article, error) {
+	response, err := retryNotFound(opts, func() (*ArticleResponse, error) {
+		return c.getArticle(id, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Article, nil
+}
+
+// getArticle retrieves the response envelope of the article with the given ID, ensuring it carries an article.
+func (c *Client) getArticle(id string, opts ...RequestOption) (*ArticleResponse, error) {
+	if id == "" {
+		return nil, errEmptyID
+	}
+
+	endpoint := withID(c.endpoint(OperationGetArticle), id)
+	body, err := c.get(context.Background(), endpoint, append(opts, operation(OperationGetArticle))...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting article: %w", asNotFound(err, "article", id))
+	}
+
+	response, err := parse[ArticleResponse](c, endpoint, body)
+	if err != nil {
+		return nil, fmt.Errorf("error getting article: %w", err)
+	}
+
+	if response.Article == nil {
+		return nil, &EmptyEntityError{Resource: "article"}
+	}
+
+	return response, nil
+}
+
+// rawArticleResponse captures the article of a ArticleResponse without decoding it.
+type rawArticleResponse struct {
+	Article json.RawMessage `json:"article"`
+}
+
+// GetRawArticle retrieves a single article by ID and returns its JSON exactly as sent by the server, without
+// decoding it into models.Article. This is useful for passthrough proxies and for fields the model does not
+// have yet. Authentication and status handling are the same as for GetArticle; the response validator is
+// not applied because nothing is decoded. To forward the whole upstream response, including its headers
+// and error statuses, use GetArticleResponse instead.
+//
+// Parameters:
+//   - id: ID of the article to retrieve
+//   - opts: Optional per-call settings such as WithContext
+//
+// Returns:
+//   - json.RawMessage: The undecoded article object
+//   - error: A *NotFoundError if no article has the ID, an *EmptyEntityError if the response carries no article, otherwise an error reporting issues in sending the request, handling the response, or parsing the JSON
+func (c *Client) GetRawArticle(id string, opts ...RequestOption) (json.RawMessage, error) {
+	if id == "" {
+		return nil, errEmptyID
+	}
+
+	endpoint := withID(c.endpoint(OperationGetArticle), id)
+	body, err := c.get(context.Background(), endpoint, append(opts, operation(OperationGetArticle))...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting raw article: %w", asNotFound(err, "article", id))
+	}
+
+	var response rawArticleResponse
+	if err := decodeJSON(endpoint, body, &response); err != nil {
+		return nil, fmt.Errorf("error getting raw article: %w", err)
+	}
+
+	if len(response.Article) == 0 || string(response.Article) == "null" {
+		return nil, &EmptyEntityError{Resource: "article"}
+	}
+
+	return response.Article, nil
+}
+
+// ArticleExists reports whether the article with the given ID exists in the Data Warehouse.
+// A HEAD request is used so no article data is transferred. If the server does not support HEAD
+// (405 Method Not Allowed or 501 Not Implemented) the check falls back to a GET of the same resource.
+//
+// Parameters:
+//   - id: ID of the article to look up
+//   - opts: Optional per-call settings such as WithContext
+//
+// Returns:
+//   - bool: True if the article exists
+//   - error: An error reporting issues in sending the request or an unexpected status code
+func (c *Client) ArticleExists(id string, opts ...RequestOption) (bool, error) {
+	if id == "" {
+		return false, errEmptyID
+	}
+
+	endpoint := withID(c.endpoint(OperationArticleExists), id)
+	return c.exists(endpoint, append(opts, operation(OperationArticleExists))...)
+}
+
+// GetArticleByURL retrieves the article stored under the given URL.
+// Since creates key on URL, this is the natural way to read back an article that was just created;
+// pass WithReadAfterWriteRetry to tolerate replication lag right after the create.
+//
+// Parameters:
+//   - articleURL: URL of the article to look up
+//   - opts: Optional per-call settings such as WithContext
+//
+// Returns:
+//   - *models.Article: The matching article
+//   - error: A *NotFoundError if no article has the URL, an error if several do, otherwise an error reporting issues in sending the request, handling the response, or parsing the JSON
+func (c *Client) GetArticleByURL(articleURL string, opts ...RequestOption) (*models.Article, error) {
+	return retryNotFound(opts, func() (*models.Article, error) {
+		return c.getArticleByURL(articleURL, opts...)
+	})
+}
+
+// getArticleByURL performs a single lookup of the article stored under the given URL.
+func (c *Client) getArticleByURL(articleURL string, opts ...RequestOption) (*models.Article, error) {
+	if articleURL == "" {
+		return nil, errors.New("url is empty")
+	}
+
+	endpoint := byURLEndpoint(c.endpoint(OperationGetArticleByURL), articleURL)
+	body, err := c.get(context.Background(), endpoint, append(opts, operation(OperationGetArticleByURL))...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting article by url: %w", err)
+	}
+
+	response, err := parse[models.DataWarehouseArticlesResponse](c, endpoint, body)
+	if err != nil {
+		return nil, fmt.Errorf("error getting article by url: %w", err)
+	}
+
+	return singleMatch(response.Articles, "article", articleURL)
+}
+
+// PaginatedArticlesResponse represents one page of articles returned by ListArticles.
+type PaginatedArticlesResponse struct {
+	Articles []models.Article `json:"articles"`
+	Total    int              `json:"total"`
+	Page     int              `json:"page"`
+	Limit    int              `json:"limit"`
+}
+
+// UnmarshalJSON decodes the response, accepting Total, Page and Limit as numbers or numeric strings.
+func (r *PaginatedArticlesResponse) UnmarshalJSON(data []byte) error {
+	type alias PaginatedArticlesResponse
+	aux := struct {
+		*alias
+		Total json.RawMessage `json:"total"`
+		Page  json.RawMessage `json:"page"`
+		Limit json.RawMessage `json:"limit"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	fields := paginationFields{Total: aux.Total, Page: aux.Page, Limit: aux.Limit}
+	return fields.decode(&r.Total, &r.Page, &r.Limit)
+}
+
+// ListArticles retrieves one page of articles.
+//
+// Parameters:
+//   - page: 1-based page number
+//   - limit: Maximum number of articles per page
+//   - opts: Optional per-call settings such as WithContext
+//
+// Returns:
+//   - *PaginatedArticlesResponse: The page of articles together with the pagination metadata
+//   - error: An error object that reports issues either in sending the request, handling the response, or parsing the JSON
+func (c *Client) ListArticles(page, limit int, opts ...RequestOption) (*PaginatedArticlesResponse, error) {
+	if err := validatePage(page, limit); err != nil {
+		return nil, err
+	}
+
+	endpoint := pageQuery(c.endpoint(OperationListArticles), page, limit)
+	body, err := c.get(context.Background(), endpoint, append(opts, operation(OperationListArticles))...)
+	if err != nil {
+		return nil, fmt.Errorf("error listing articles: %w", err)
+	}
+
+	response, err := parse[PaginatedArticlesResponse](c, endpoint, body)
+	if err != nil {
+		return nil, fmt.Errorf("error listing articles: %w", err)
+	}
+
+	return response, nil
+}
+
+// ListArticlesSorted retrieves one page of articles ordered by the given field.
+//
+// Parameters:
+//   - sortBy: Field to order by, one of id, title, created_at or updated_at
+//   - order: SortAsc or SortDesc
+//   - page: 1-based page number
+//   - limit: Maximum number of articles per page
+//   - opts: Optional per-call settings such as WithContext
+//
+// Returns:
+//   - *PaginatedArticlesResponse: The page of articles together with the pagination metadata
+//   - error: An error if sortBy or order is not supported, or an error reporting issues in sending the request, handling the response, or parsing the JSON
+func (c *Client) ListArticlesSorted(sortBy, order string, page, limit int, opts ...RequestOption) (*PaginatedArticlesResponse, error) {
+	if err := validateSort(sortBy, order); err != nil {
+		return nil, err
+	}
+	if err := validatePage(page, limit); err != nil {
+		return nil, err
+	}
+
+	endpoint := sortQuery(pageQuery(c.endpoint(OperationListArticles), page, limit), sortBy, order)
+	body, err := c.get(context.Background(), endpoint, append(opts, operation(OperationListArticles))...)
+	if err != nil {
+		return nil, fmt.Errorf("error listing sorted articles: %w", err)
+	}
+
+	response, err := parse[PaginatedArticlesResponse](c, endpoint, body)
+	if err != nil {
+		return nil, fmt.Errorf("error listing sorted articles: %w", err)
+	}
+
+	return response, nil
+}
+
+// GetArticlesModifiedSince retrieves one page of the articles whose updated_at is at or after since,
+// for incremental syncs that only pull changed records. The timestamp is sent as updated_since in
+// RFC 3339 format in UTC.
+//
+// Parameters:
+//   - since: Lower bound, inclusive, of the articles' update time; must not be in the future according to the client's clock
+//   - page: 1-based page number
+//   - limit: Maximum number of articles per page
+//   - opts: Optional per-call settings such as WithContext
+//
+// Returns:
+//   - *PaginatedArticlesResponse: The page of changed articles together with the pagination metadata
+//   - error: An error if since is zero or in the future, or an error reporting issues in sending the request, handling the response, or parsing the JSON
+func (c *Client) GetArticlesModifiedSince(since time.Time, page, limit int, opts ...RequestOption) (*PaginatedArticlesResponse, error) {
+	if err := c.validateSince(since); err != nil {
+		return nil, err
+	}
+	if err := validatePage(page, limit); err != nil {
+		return nil, err
+	}
+
+	endpoint := modifiedSinceQuery(pageQuery(c.endpoint(OperationListArticles), page, limit), since)
+	body, err := c.get(context.Background(), endpoint, append(opts, operation(OperationListArticles))...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting modified articles: %w", err)
+	}
+
+	response, err := parse[PaginatedArticlesResponse](c, endpoint, body)
+	if err != nil {
+		return nil, fmt.Errorf("error getting modified articles: %w", err)
+	}
+
+	return response, nil
+}
+
+// FetchAllArticles retrieves every article by reading the first page to learn the total and then
+// fetching the remaining pages concurrently, which is much faster than paging sequentially.
+// Articles are returned in page order. If articles are created while paging, an article that shifts
+// onto a later page is only returned once, but articles created after the first page was read may be missed.
+//
+// Parameters:
+//   - ctx: Context controlling cancellation of the page requests
+//   - limit: Page size
+//   - concurrency: Maximum number of concurrent page requests
+//
+// Returns:
+//   - []models.Article: All articles
+//   - error: The first error encountered while fetching a page; it cancels the remaining page requests
+func (c *Client) FetchAllArticles(ctx context.Context, limit, concurrency int) ([]models.Article, error) {
+	fetch := func(ctx context.Context, page int) ([]models.Article, int, error) {
+		response, err := c.ListArticles(page, limit, WithContext(ctx))
+		if err != nil {
+			return nil, 0, err
+		}
+		return response.Articles, response.Total, nil
+	}
+
+	return fetchAllPages(ctx, limit, concurrency, fetch, func(a models.Article) string { return a.ID })
+}
+
+// prepareArticleRequest applies the client-wide request policy, such as default tags and tag normalization, to an article request.
+// The request is received by value and its Tags slice is rebuilt, so the caller's data is never mutated.
+func (c *Client) prepareArticleRequest(request models.DataWarehouseCreateArticleRequest) models.DataWarehouseCreateArticleRequest {
+	request.Tags = c.prepareTags(request.Tags)
+	return request
+}
@@ -0,0 +1,118 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestPaginatedArticlesResponseNumberForms(t *testing.T) {
+	for name, body := range map[string]string{
+		"numbers": `{"articles":[{"id":"a"}],"total":100,"page":2,"limit":25}`,
+		"strings": `{"articles":[{"id":"a"}],"total":"100","page":"2","limit":" 25 "}`,
+		"floats":  `{"articles":[{"id":"a"}],"total":100.0,"page":2e0,"limit":"25.0"}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			var response PaginatedArticlesResponse
+			if err := json.Unmarshal([]byte(body), &response); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+			if response.Total != 100 || response.Page != 2 || response.Limit != 25 {
+				t.Errorf("total, page, limit = %d, %d, %d, want 100, 2, 25", response.Total, response.Page, response.Limit)
+			}
+			if len(response.Articles) != 1 || response.Articles[0].ID != "a" {
+				t.Errorf("Articles = %+v", response.Articles)
+			}
+		})
+	}
+}
+
+func TestPaginatedArticlesResponseMissingAndInvalidCounts(t *testing.T) {
+	var response PaginatedArticlesResponse
+	if err := json.Unmarshal([]byte(`{"articles":[],"total":null}`), &response); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if response.Total != 0 || response.Page != 0 || response.Limit != 0 {
+		t.Errorf("total, page, limit = %d, %d, %d, want zeros", response.Total, response.Page, response.Limit)
+	}
+
+	for _, body := range []string{`{"total":"many"}`, `{"total":10.5}`, `{"page":true}`} {
+		if err := json.Unmarshal([]byte(body), &response); err == nil {
+			t.Errorf("Unmarshal(%s) error = nil, want an error", body)
+		}
+	}
+}
+
+func TestListArticlesAcceptsStringCounts(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, `{"articles":[{"id":"a"}],"total":"41","page":"1","limit":"20"}`)
+	}))
+
+	response, err := c.ListArticles(1, 20)
+	if err != nil {
+		t.Fatalf("ListArticles() error = %v", err)
+	}
+	if response.Total != 41 || response.Page != 1 || response.Limit != 20 {
+		t.Errorf("total, page, limit = %d, %d, %d, want 41, 1, 20", response.Total, response.Page, response.Limit)
+	}
+}
+
+func TestDeleteArticleIfMatch(t *testing.T) {
+	for name, test := range map[string]struct {
+		opts    []RequestOption
+		ifMatch string
+	}{
+		"plain":    {},
+		"if-match": {opts: []RequestOption{WithIfMatch(`"v2"`)}, ifMatch: `"v2"`},
+	} {
+		t.Run(name, func(t *testing.T) {
+			c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodDelete {
+					t.Errorf("method = %s, want DELETE", r.Method)
+				}
+				if got := r.Header.Get("If-Match"); got != test.ifMatch {
+					t.Errorf("If-Match = %q, want %q", got, test.ifMatch)
+				}
+				w.WriteHeader(http.StatusNoContent)
+			}))
+
+			if err := c.DeleteArticle("a1", test.opts...); err != nil {
+				t.Fatalf("DeleteArticle() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestDeleteArticlePreconditionFailed(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusPreconditionFailed, `{"error":"etag mismatch"}`)
+	}))
+
+	err := c.DeleteArticle("a1", WithIfMatch(`"v1"`))
+	var conflict *ConflictError
+	if !errors.As(err, &conflict) {
+		t.Fatalf("DeleteArticle() error = %v, want *ConflictError", err)
+	}
+	if conflict.StatusCode != http.StatusPreconditionFailed {
+		t.Errorf("StatusCode = %d, want %d", conflict.StatusCode, http.StatusPreconditionFailed)
+	}
+}
+
+func TestGetArticleNoContent(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	article, err := c.GetArticle("a1")
+	if !errors.Is(err, ErrNoContent) {
+		t.Fatalf("GetArticle() error = %v, want ErrNoContent", err)
+	}
+	if article != nil {
+		t.Errorf("GetArticle() article = %+v, want nil", article)
+	}
+	var notFound *NotFoundError
+	if errors.As(err, &notFound) {
+		t.Errorf("GetArticle() error = %v, want it distinct from *NotFoundError", err)
+	}
+}
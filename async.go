@@ -0,0 +1,250 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+
+	"github.com/0ffsideCompass/models"
+)
+
+// AsyncFullPolicy decides what CreateArticleAsync does when the queue of WithAsyncCreates is full.
+type AsyncFullPolicy int
+
+const (
+	// AsyncBlock makes CreateArticleAsync wait until the queue has room, applying backpressure to the caller.
+	AsyncBlock AsyncFullPolicy = iota
+	// AsyncDrop makes CreateArticleAsync discard the request and return ErrAsyncQueueFull.
+	AsyncDrop
+)
+
+var (
+	// ErrAsyncDisabled is returned by CreateArticleAsync and Flush when WithAsyncCreates is not set.
+	ErrAsyncDisabled = errors.New("async creates are not enabled")
+	// ErrAsyncQueueFull is returned by CreateArticleAsync under AsyncDrop when the queue is full.
+	ErrAsyncQueueFull = errors.New("async create queue is full")
+	// ErrClientClosed is returned by CreateArticleAsync after Close.
+	ErrClientClosed = errors.New("client is closed")
+)
+
+// asyncCreator queues article creates and processes them with a pool of background workers.
+type asyncCreator struct {
+	workers int
+	policy  AsyncFullPolicy
+	onError func(models.DataWarehouseCreateArticleRequest, error)
+	queue   chan asyncItem
+
+	// closeMu guards closed and keeps Close from closing the queue while a send is in progress.
+	closeMu sync.RWMutex
+	closed  bool
+	done    sync.WaitGroup
+
+	mu sync.Mutex
+	// seq is the sequence number of the last create passed to CreateArticleAsync.
+	seq uint64
+	// completed is the sequence number up to which every create has finished.
+	completed uint64
+	// finished holds the creates above completed that finished out of order.
+	finished map[uint64]bool
+	waiters  []flushWaiter
+	// flush is closed and replaced by Flush to make batching workers send their partial batches.
+	flush chan struct{}
+}
+
+// asyncItem is a queued create and its sequence number.
+type asyncItem struct {
+	seq     uint64
+	request models.DataWarehouseCreateArticleRequest
+}
+
+// flushWaiter is a Flush call waiting for every create up to seq to finish.
+type flushWaiter struct {
+	seq  uint64
+	done chan struct{}
+}
+
+// WithAsyncCreates enables CreateArticleAsync, which queues article creates for a pool of background
+// workers so telemetry-style ingestion does not wait on Data Warehouse latency. Failed creates, after
+// any retries configured with WithRetry, are reported to onError, which is called from the workers and
+// must be safe for concurrent use. Use Flush to wait for the queue to drain and Close to stop the
+// workers once the queued creates are done.
+//
+// Parameters:
+//   - workers: Number of creates processed concurrently
+//   - queueSize: Number of creates that can wait in the queue
+//   - policy: AsyncBlock to wait for room when the queue is full, AsyncDrop to reject the create
+//   - onError: Callback receiving every create that failed and its error; may be nil to ignore failures
+//
+// Returns:
+//   - Option: Option enabling async creates
+func WithAsyncCreates(workers, queueSize int, policy AsyncFullPolicy, onError func(request models.DataWarehouseCreateArticleRequest, err error)) Option {
+	return func(c *Client) error {
+		if workers < 1 {
+			return errors.New("async workers must be at least 1")
+		}
+		if queueSize < 0 {
+			return errors.New("async queue size must not be negative")
+		}
+		if policy != AsyncBlock && policy != AsyncDrop {
+			return errors.New("unknown async queue policy")
+		}
+		c.asyncCreator = &asyncCreator{
+			workers:  workers,
+			policy:   policy,
+			onError:  onError,
+			queue:    make(chan asyncItem, queueSize),
+			finished: make(map[uint64]bool),
+			flush:    make(chan struct{}),
+		}
+		return nil
+	}
+}
+
+// CreateArticleAsync queues request to be created by the background workers of WithAsyncCreates and
+// returns without waiting for the create. When the queue is full it blocks or returns
+// ErrAsyncQueueFull, depending on the configured policy. The outcome of the create is only reported
+// to the onError callback on failure. The request's Tags are copied when it is queued, so the caller
+// may reuse the slice as soon as CreateArticleAsync returns.
+//
+// Parameters:
+//   - request: CreateArticleRequest containing the article details
+//
+// Returns:
+//   - error: ErrAsyncDisabled if WithAsyncCreates is not set, ErrAsyncQueueFull if the request was dropped, or ErrClientClosed after Close
+func (c *Client) CreateArticleAsync(request models.DataWarehouseCreateArticleRequest) error {
+	a := c.asyncCreator
+	if a == nil {
+		return ErrAsyncDisabled
+	}
+
+	a.closeMu.RLock()
+	defer a.closeMu.RUnlock()
+	if a.closed {
+		return ErrClientClosed
+	}
+
+	request.Tags = slices.Clone(request.Tags)
+	item := asyncItem{seq: a.next(), request: request}
+	if a.policy == AsyncDrop {
+		select {
+		case a.queue <- item:
+		default:
+			a.finish(item.seq)
+			return ErrAsyncQueueFull
+		}
+		return nil
+	}
+
+	a.queue <- item
+	return nil
+}
+
+// Flush waits until every create queued with CreateArticleAsync before the call has been processed.
+// Creates queued while Flush waits are not waited for, so Flush returns under steady traffic.
+//
+// Parameters:
+//   - ctx: Context bounding the wait
+//
+// Returns:
+//   - error: ErrAsyncDisabled if WithAsyncCreates is not set, or ctx.Err() if ctx is done before the queue drained
+func (c *Client) Flush(ctx context.Context) error {
+	a := c.asyncCreator
+	if a == nil {
+		return ErrAsyncDisabled
+	}
+
+	a.mu.Lock()
+	if a.completed >= a.seq {
+		a.mu.Unlock()
+		return nil
+	}
+	waiter := flushWaiter{seq: a.seq, done: make(chan struct{})}
+	a.waiters = append(a.waiters, waiter)
+	close(a.flush)
+	a.flush = make(chan struct{})
+	a.mu.Unlock()
+
+	select {
+	case <-waiter.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// start launches the workers, which batch their creates if WithAutoBatch is set.
+func (a *asyncCreator) start(c *Client) {
+	a.done.Add(a.workers)
+	for range a.workers {
+		go func() {
+			defer a.done.Done()
+			if c.autoBatch != nil {
+				a.batchWorker(c, c.autoBatch)
+				return
+			}
+			for item := range a.queue {
+				if err := c.CreateArticle(item.request); err != nil && a.onError != nil {
+					a.onError(item.request, err)
+				}
+				a.finish(item.seq)
+			}
+		}()
+	}
+}
+
+// close stops accepting creates, lets the workers finish the queued ones and waits for them to exit.
+func (a *asyncCreator) close() {
+	a.closeMu.Lock()
+	if a.closed {
+		a.closeMu.Unlock()
+		return
+	}
+	a.closed = true
+	close(a.queue)
+	a.closeMu.Unlock()
+
+	a.done.Wait()
+}
+
+// flushRequested returns the channel closed by the next call to Flush.
+func (a *asyncCreator) flushRequested() <-chan struct{} {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.flush
+}
+
+// next assigns the sequence number of a new create.
+func (a *asyncCreator) next() uint64 {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.seq++
+	return a.seq
+}
+
+// finish records that the creates with the given sequence numbers are done, waking the Flush callers
+// whose creates have all finished.
+func (a *asyncCreator) finish(seqs ...uint64) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for _, seq := range seqs {
+		a.finished[seq] = true
+	}
+	for a.finished[a.completed+1] {
+		delete(a.finished, a.completed+1)
+		a.completed++
+	}
+
+	waiting := a.waiters[:0]
+	for _, waiter := range a.waiters {
+		if waiter.seq <= a.completed {
+			close(waiter.done)
+			continue
+		}
+		waiting = append(waiting, waiter)
+	}
+	a.waiters = waiting
+}
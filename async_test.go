@@ -0,0 +1,177 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/0ffsideCompass/models"
+)
+
+func TestFlushWaitsForQueuedCreates(t *testing.T) {
+	var created atomic.Int64
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.Copy(io.Discard, r.Body)
+		time.Sleep(5 * time.Millisecond)
+		created.Add(1)
+		writeJSON(w, http.StatusCreated, `{}`)
+	}), WithAsyncCreates(2, 16, AsyncBlock, nil))
+
+	for range 10 {
+		if err := c.CreateArticleAsync(models.DataWarehouseCreateArticleRequest{Title: "a"}); err != nil {
+			t.Fatalf("CreateArticleAsync() error = %v", err)
+		}
+	}
+
+	if err := c.Flush(context.Background()); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+	if got := created.Load(); got < 10 {
+		t.Errorf("created %d articles before Flush returned, want 10", got)
+	}
+}
+
+func TestFlushReturnsUnderSteadyTraffic(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.Copy(io.Discard, r.Body)
+		time.Sleep(time.Millisecond)
+		writeJSON(w, http.StatusCreated, `{}`)
+	}), WithAsyncCreates(2, 64, AsyncBlock, nil))
+
+	stop := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+				c.CreateArticleAsync(models.DataWarehouseCreateArticleRequest{Title: "a"})
+			}
+		}
+	}()
+	defer func() {
+		close(stop)
+		wg.Wait()
+	}()
+
+	time.Sleep(20 * time.Millisecond)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := c.Flush(ctx); err != nil {
+		t.Fatalf("Flush() error = %v, want nil while creates keep arriving", err)
+	}
+}
+
+func TestCreateArticleAsyncDropsWhenFull(t *testing.T) {
+	release := make(chan struct{})
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.Copy(io.Discard, r.Body)
+		<-release
+		writeJSON(w, http.StatusCreated, `{}`)
+	}), WithAsyncCreates(1, 1, AsyncDrop, nil))
+
+	var dropped bool
+	for range 5 {
+		if err := c.CreateArticleAsync(models.DataWarehouseCreateArticleRequest{Title: "a"}); err == ErrAsyncQueueFull {
+			dropped = true
+		}
+	}
+	close(release)
+	if !dropped {
+		t.Error("CreateArticleAsync() never returned ErrAsyncQueueFull")
+	}
+	if err := c.Flush(context.Background()); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+}
+
+func TestCreateArticleAsyncCopiesTags(t *testing.T) {
+	release := make(chan struct{})
+	var mu sync.Mutex
+	var sent [][]string
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		var body struct {
+			Tags []string `json:"tags"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		mu.Lock()
+		sent = append(sent, body.Tags)
+		mu.Unlock()
+		writeJSON(w, http.StatusCreated, `{}`)
+	}), WithAsyncCreates(1, 4, AsyncBlock, nil))
+
+	tags := []string{"football", "derby"}
+	for range 2 {
+		request := testArticleRequest()
+		request.Tags = tags
+		if err := c.CreateArticleAsync(request); err != nil {
+			t.Fatalf("CreateArticleAsync() error = %v", err)
+		}
+		tags[0], tags[1] = "reused", "buffer"
+	}
+	close(release)
+
+	if err := c.Flush(context.Background()); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if len(sent) != 2 || !slices.Equal(sent[0], []string{"football", "derby"}) || !slices.Equal(sent[1], []string{"reused", "buffer"}) {
+		t.Errorf("sent tags %q, want the tags as they were when each create was queued", sent)
+	}
+}
+
+func TestFlushDuringInFlightBatch(t *testing.T) {
+	inFlight := make(chan struct{}, 1)
+	release := make(chan struct{})
+	var batches, created atomic.Int64
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Articles []json.RawMessage `json:"articles"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		if batches.Add(1) == 1 {
+			inFlight <- struct{}{}
+			<-release
+		}
+		created.Add(int64(len(body.Articles)))
+		writeJSON(w, http.StatusCreated, `{}`)
+	}), WithAsyncCreates(1, 16, AsyncBlock, nil), WithAutoBatch(2, time.Hour, nil))
+
+	for range 2 {
+		if err := c.CreateArticleAsync(testArticleRequest()); err != nil {
+			t.Fatalf("CreateArticleAsync() error = %v", err)
+		}
+	}
+	<-inFlight
+	if err := c.CreateArticleAsync(testArticleRequest()); err != nil {
+		t.Fatalf("CreateArticleAsync() error = %v", err)
+	}
+
+	flushed := make(chan error, 1)
+	go func() { flushed <- c.Flush(context.Background()) }()
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+
+	select {
+	case err := <-flushed:
+		if err != nil {
+			t.Fatalf("Flush() error = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Flush() did not return, want the signal sent during the in-flight batch to flush the partial batch")
+	}
+	if got := created.Load(); got != 3 {
+		t.Errorf("created %d articles before Flush returned, want 3", got)
+	}
+}
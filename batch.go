@@ -0,0 +1,242 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/0ffsideCompass/models"
+)
+
+const (
+	batchArticlesEndpoint = "/api/v1/articles/batch"
+)
+
+// batchCreateArticlesRequest is the request body of the article batch endpoint.
+type batchCreateArticlesRequest struct {
+	Articles []interface{} `json:"articles"`
+}
+
+// BatchCreateArticles creates or updates several articles in a single request, which amortizes the
+// HTTP overhead of many small creates. Client-wide request policy and validation apply to every article
+// as for CreateArticle; if any article is invalid nothing is sent. The batch succeeds or fails as a whole.
+// When WithMaxRequestBytes is set, a batch whose body would exceed the limit is split into several
+// requests, sent in order; if one of them fails, the articles of the requests sent before it remain
+// created. A single article larger than the limit fails the call with ErrRequestTooLarge before anything
+// is sent.
+//
+// Parameters:
+//   - requests: CreateArticleRequests containing the article details
+//   - opts: Optional per-call settings such as WithContext
+//
+// Returns:
+//   - error: An error if an article is invalid, otherwise an error reporting issues in sending the request or handling the response
+func (c *Client) BatchCreateArticles(requests []models.DataWarehouseCreateArticleRequest, opts ...RequestOption) error {
+	if len(requests) == 0 {
+		return errors.New("error creating article batch: no articles")
+	}
+
+	articles := make([]interface{}, 0, len(requests))
+	for i, request := range requests {
+		request = c.prepareArticleRequest(request)
+		if err := c.validateRequest(articleRequest, request); err != nil {
+			return fmt.Errorf("error creating article batch: article %d: %w", i, err)
+		}
+		article := c.mapFields(request)
+		if len(c.bodyValidators) > 0 {
+			data, err := c.marshal(article)
+			if err != nil {
+				return fmt.Errorf("error creating article batch: article %d: error marshalling data to JSON: %w", i, err)
+			}
+			if err := c.validateRequestBody(articleRequest, data); err != nil {
+				return fmt.Errorf("error creating article batch: article %d: %w", i, err)
+			}
+		}
+		articles = append(articles, article)
+	}
+
+	chunks, err := c.splitBatch(articles)
+	if err != nil {
+		return fmt.Errorf("error creating article batch: %w", err)
+	}
+
+	for _, chunk := range chunks {
+		body := batchCreateArticlesRequest{Articles: chunk}
+		_, err := c.post(context.Background(), c.endpoint(OperationBatchCreateArticles), body, append(opts, acceptStatus(http.StatusCreated), operation(OperationBatchCreateArticles), bodyUnused())...)
+		if err != nil {
+			return fmt.Errorf("error creating article batch: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// batchEnvelopeBytes is the size of the batch request body without articles: {"articles":[]}.
+const batchEnvelopeBytes = len(`{"articles":[]}`)
+
+// splitBatch groups articles into consecutive chunks whose batch request body stays within the
+// WithMaxRequestBytes limit, estimating each body as the sum of the encoded articles, the separating
+// commas and the envelope. Without a limit all articles form one chunk, and no articles form no chunks.
+func (c *Client) splitBatch(articles []interface{}) ([][]interface{}, error) {
+	if len(articles) == 0 {
+		return nil, nil
+	}
+	if c.maxRequestBytes <= 0 {
+		return [][]interface{}{articles}, nil
+	}
+
+	var (
+		chunks [][]interface{}
+		chunk  []interface{}
+		size   int64
+	)
+	for i, article := range articles {
+		encoded, err := c.marshal(article)
+		if err != nil {
+			return nil, fmt.Errorf("error marshalling data to JSON: %w", err)
+		}
+
+		articleSize := int64(len(encoded))
+		if int64(batchEnvelopeBytes)+articleSize > c.maxRequestBytes {
+			return nil, fmt.Errorf("article %d: %w: %d bytes exceed the limit of %d", i, ErrRequestTooLarge, int64(batchEnvelopeBytes)+articleSize, c.maxRequestBytes)
+		}
+
+		separator := int64(0)
+		if len(chunk) > 0 {
+			separator = 1
+		}
+		if len(chunk) > 0 && int64(batchEnvelopeBytes)+size+separator+articleSize > c.maxRequestBytes {
+			chunks = append(chunks, chunk)
+			chunk, size, separator = nil, 0, 0
+		}
+
+		chunk = append(chunk, article)
+		size += separator + articleSize
+	}
+
+	return append(chunks, chunk), nil
+}
+
+// autoBatch holds the settings of WithAutoBatch.
+type autoBatch struct {
+	maxItems    int
+	maxInterval time.Duration
+	onError     func(requests []models.DataWarehouseCreateArticleRequest, err error)
+}
+
+// WithAutoBatch makes the workers of WithAsyncCreates accumulate queued creates and send them with
+// BatchCreateArticles once maxItems are collected or maxInterval has passed since the first create of
+// the batch, whichever comes first. Each worker batches independently. Flush and Close send the
+// partial batches immediately. Failed batches are reported to onError with all their requests; when
+// onError is nil, the onError callback of WithAsyncCreates receives each request of the batch instead.
+// WithAutoBatch requires WithAsyncCreates.
+//
+// Parameters:
+//   - maxItems: Maximum number of creates per batch
+//   - maxInterval: Maximum time a create waits in a partial batch
+//   - onError: Optional callback receiving every failed batch and its error; may be nil
+//
+// Returns:
+//   - Option: Option enabling automatic batching
+func WithAutoBatch(maxItems int, maxInterval time.Duration, onError func(requests []models.DataWarehouseCreateArticleRequest, err error)) Option {
+	return func(c *Client) error {
+		if maxItems < 1 {
+			return errors.New("batch size must be at least 1")
+		}
+		if maxInterval <= 0 {
+			return errors.New("batch interval must be positive")
+		}
+		c.autoBatch = &autoBatch{maxItems: maxItems, maxInterval: maxInterval, onError: onError}
+		return nil
+	}
+}
+
+// batchWorker accumulates queued creates into batches and sends them until the queue is closed.
+// The worker keeps the flush channel it last saw rather than asking for the current one on every
+// iteration, so a Flush call made while a batch is in flight is noticed once the send returns.
+func (a *asyncCreator) batchWorker(c *Client, config *autoBatch) {
+	var batch []asyncItem
+	timer := time.NewTimer(config.maxInterval)
+	timer.Stop()
+	defer timer.Stop()
+	requested := a.flushRequested()
+
+	flush := func() {
+		timer.Stop()
+		if len(batch) == 0 {
+			return
+		}
+		requests := make([]models.DataWarehouseCreateArticleRequest, len(batch))
+		seqs := make([]uint64, len(batch))
+		for i, item := range batch {
+			requests[i] = item.request
+			seqs[i] = item.seq
+		}
+		if err := c.BatchCreateArticles(requests); err != nil {
+			a.reportBatchError(config, requests, err)
+		}
+		a.finish(seqs...)
+		batch = nil
+	}
+
+	for {
+		var expired <-chan time.Time
+		if len(batch) > 0 {
+			expired = timer.C
+		}
+
+		select {
+		case item, ok := <-a.queue:
+			if !ok {
+				flush()
+				return
+			}
+			batch = append(batch, item)
+			if len(batch) == 1 {
+				timer.Reset(config.maxInterval)
+			}
+			if len(batch) >= config.maxItems {
+				flush()
+			}
+		case <-expired:
+			flush()
+		case <-requested:
+			requested = a.flushRequested()
+			// Creates still in the queue were passed to CreateArticleAsync before Flush, so they are
+			// sent now instead of waiting for the batch interval. The queue length bounds the drain so
+			// steady traffic cannot keep the worker here.
+			for range len(a.queue) {
+				select {
+				case item, ok := <-a.queue:
+					if !ok {
+						flush()
+						return
+					}
+					batch = append(batch, item)
+					if len(batch) >= config.maxItems {
+						flush()
+					}
+				default:
+				}
+			}
+			flush()
+		}
+	}
+}
+
+// reportBatchError passes a failed batch to the batch error callback or, if there is none, each of
+// its requests to the async error callback.
+func (a *asyncCreator) reportBatchError(config *autoBatch, batch []models.DataWarehouseCreateArticleRequest, err error) {
+	if config.onError != nil {
+		config.onError(batch, err)
+		return
+	}
+	if a.onError == nil {
+		return
+	}
+	for _, request := range batch {
+		a.onError(request, err)
+	}
+}
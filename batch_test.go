@@ -0,0 +1,74 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestSplitBatch(t *testing.T) {
+	// Every article encodes to 7 bytes, so a limit of 38 bytes fits three of them with their two
+	// separating commas in the 15 byte envelope.
+	article := json.RawMessage(`{"a":1}`)
+	articles := func(n int) []interface{} {
+		list := make([]interface{}, n)
+		for i := range list {
+			list[i] = article
+		}
+		return list
+	}
+
+	tests := []struct {
+		name     string
+		limit    int64
+		articles []interface{}
+		want     []int
+	}{
+		{name: "no limit", limit: 0, articles: articles(10), want: []int{10}},
+		{name: "empty", limit: 38, articles: nil, want: nil},
+		{name: "one chunk at the limit", limit: 38, articles: articles(3), want: []int{3}},
+		{name: "exact multiple", limit: 38, articles: articles(6), want: []int{3, 3}},
+		{name: "one past a multiple", limit: 38, articles: articles(4), want: []int{3, 1}},
+		{name: "one article per chunk", limit: 22, articles: articles(3), want: []int{1, 1, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Client{marshal: json.Marshal, maxRequestBytes: tt.limit}
+			chunks, err := c.splitBatch(tt.articles)
+			if err != nil {
+				t.Fatalf("splitBatch() error = %v", err)
+			}
+
+			sizes := make([]int, 0, len(chunks))
+			for _, chunk := range chunks {
+				sizes = append(sizes, len(chunk))
+				body, err := json.Marshal(batchCreateArticlesRequest{Articles: chunk})
+				if err != nil {
+					t.Fatalf("marshalling chunk: %v", err)
+				}
+				if tt.limit > 0 && int64(len(body)) > tt.limit {
+					t.Errorf("chunk body is %d bytes, want at most %d", len(body), tt.limit)
+				}
+			}
+			if !slices.Equal(sizes, tt.want) {
+				t.Errorf("chunk sizes = %v, want %v", sizes, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitBatchRejectsOversizedArticle(t *testing.T) {
+	c := &Client{marshal: json.Marshal, maxRequestBytes: 38}
+	oversized := json.RawMessage(`{"title":"far too long for the limit"}`)
+
+	_, err := c.splitBatch([]interface{}{json.RawMessage(`{"a":1}`), oversized})
+	if !errors.Is(err, ErrRequestTooLarge) {
+		t.Fatalf("splitBatch() error = %v, want ErrRequestTooLarge", err)
+	}
+	if !strings.Contains(err.Error(), "article 1") {
+		t.Errorf("splitBatch() error = %v, want it to name the oversized article", err)
+	}
+}
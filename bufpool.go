@@ -0,0 +1,39 @@
+package client
+
+import (
+	"bytes"
+	"io"
+	"sync"
+)
+
+const (
+	// maxPooledBufferSize is the capacity above which a read buffer is dropped instead of being returned
+	// to the pool, so one large response does not pin its memory for the lifetime of the process.
+	maxPooledBufferSize = 1 << 20
+)
+
+// bufferPool holds the buffers response bodies are read into.
+var bufferPool = sync.Pool{
+	New: func() interface{} {
+		return new(bytes.Buffer)
+	},
+}
+
+// readPooled reads r to completion into a pooled buffer and returns a copy of exactly the bytes read.
+// Reading into a reused buffer avoids the repeated growth allocations of io.ReadAll; the copy is owned
+// by the caller, so the buffer can be reset and reused as soon as the read completes.
+func readPooled(r io.Reader) ([]byte, error) {
+	buf := bufferPool.Get().(*bytes.Buffer)
+	buf.Reset()
+	defer func() {
+		if buf.Cap() <= maxPooledBufferSize {
+			bufferPool.Put(buf)
+		}
+	}()
+
+	if _, err := buf.ReadFrom(r); err != nil {
+		return nil, err
+	}
+
+	return bytes.Clone(buf.Bytes()), nil
+}
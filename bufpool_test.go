@@ -0,0 +1,74 @@
+package client
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestReadPooledVaryingSizes(t *testing.T) {
+	sizes := []int{4096, 10, 0, 70000, 3, maxPooledBufferSize + 1, 512}
+	inputs := make([][]byte, len(sizes))
+	results := make([][]byte, len(sizes))
+	for i, size := range sizes {
+		inputs[i] = bytes.Repeat([]byte{byte('a' + i)}, size)
+
+		got, err := readPooled(bytes.NewReader(inputs[i]))
+		if err != nil {
+			t.Fatalf("readPooled(%d bytes) error = %v", size, err)
+		}
+		results[i] = got
+	}
+
+	for i, got := range results {
+		if !bytes.Equal(got, inputs[i]) {
+			t.Errorf("result of the %d byte read was altered by later reads", sizes[i])
+		}
+	}
+}
+
+func TestReadPooledResultsAreIndependent(t *testing.T) {
+	first, err := readPooled(strings.NewReader("first response"))
+	if err != nil {
+		t.Fatalf("readPooled() error = %v", err)
+	}
+	second, err := readPooled(strings.NewReader("second"))
+	if err != nil {
+		t.Fatalf("readPooled() error = %v", err)
+	}
+
+	if string(first) != "first response" || string(second) != "second" {
+		t.Errorf("results = %q, %q, want %q, %q", first, second, "first response", "second")
+	}
+}
+
+func TestReadPooledError(t *testing.T) {
+	failure := errors.New("connection reset")
+	if _, err := readPooled(iotest.ErrReader(failure)); !errors.Is(err, failure) {
+		t.Errorf("readPooled() error = %v, want %v", err, failure)
+	}
+}
+
+func BenchmarkReadResponseBody(b *testing.B) {
+	body := []byte(`{"article":{"id":"a1","title":"` + strings.Repeat("x", 2048) + `","tags":["football"]}}`)
+
+	b.Run("io.ReadAll", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := io.ReadAll(bytes.NewReader(body)); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("readPooled", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := readPooled(bytes.NewReader(body)); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -0,0 +1,108 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/0ffsideCompass/models"
+)
+
+// ArticleChangeDetector reports whether request would change the stored article existing.
+// It is called with the request after client-wide policy such as default tags has been applied.
+type ArticleChangeDetector func(existing *models.Article, request models.DataWarehouseCreateArticleRequest) bool
+
+// BulkUpsertReport lists, by URL, which articles BulkUpsertArticles wrote and which it skipped.
+type BulkUpsertReport struct {
+	Written   []string
+	Unchanged []string
+}
+
+// WithArticleChangeDetector replaces the comparison BulkUpsertArticles uses to decide whether an
+// existing article needs to be written. The default is ArticleChanged.
+//
+// Parameters:
+//   - detector: Function reporting whether a request differs from the stored article
+//
+// Returns:
+//   - Option: Option setting the change detector
+func WithArticleChangeDetector(detector ArticleChangeDetector) Option {
+	return func(c *Client) error {
+		if detector == nil {
+			return errors.New("article change detector is nil")
+		}
+		c.articleChanged = detector
+		return nil
+	}
+}
+
+// ArticleChanged is the default ArticleChangeDetector. It reports a change when the title differs or
+// the tags differ, ignoring tag order. Other request fields are not stored on models.Article and
+// therefore cannot be compared.
+//
+// Parameters:
+//   - existing: The article currently stored under the request's URL
+//   - request: The article request to compare
+//
+// Returns:
+//   - bool: True if the request would change the article
+func ArticleChanged(existing *models.Article, request models.DataWarehouseCreateArticleRequest) bool {
+	if existing.Title != request.Title {
+		return true
+	}
+
+	stored := slices.Clone(existing.Tags)
+	wanted := slices.Clone(request.Tags)
+	slices.Sort(stored)
+	slices.Sort(wanted)
+
+	return !slices.Equal(slices.Compact(stored), slices.Compact(wanted))
+}
+
+// BulkUpsertArticles creates or updates the given articles, skipping those whose stored version is
+// unchanged so that repeated syncs do not rewrite the whole collection. Each article is looked up by
+// URL; new articles and articles reported as changed by the change detector are written with
+// CreateArticle. Requests are processed in order and processing stops at the first error, in which
+// case the report covers the requests handled so far. The lookups only receive the context and headers
+// of opts, so options meant for the writes, such as WithReadAfterWriteRetry, do not change how
+// existing articles are found.
+//
+// Parameters:
+//   - requests: Article requests to upsert; every request must have a URL
+//   - opts: Optional per-call settings applied to every write, such as WithContext
+//
+// Returns:
+//   - *BulkUpsertReport: URLs of the written and skipped articles
+//   - error: The first error encountered while looking up or writing an article
+func (c *Client) BulkUpsertArticles(requests []models.DataWarehouseCreateArticleRequest, opts ...RequestOption) (*BulkUpsertReport, error) {
+	changed := c.articleChanged
+	if changed == nil {
+		changed = ArticleChanged
+	}
+
+	lookupOpts := lookupOptions(opts)
+	report := &BulkUpsertReport{}
+	for _, request := range requests {
+		if request.URL == "" {
+			return report, errors.New("error upserting article: url is empty")
+		}
+
+		existing, err := c.GetArticleByURL(request.URL, lookupOpts...)
+		var notFound *NotFoundError
+		switch {
+		case errors.As(err, &notFound):
+		case err != nil:
+			return report, fmt.Errorf("error upserting article %s: %w", request.URL, err)
+		case !changed(existing, c.prepareArticleRequest(request)):
+			report.Unchanged = append(report.Unchanged, request.URL)
+			continue
+		}
+
+		if err := c.CreateArticle(request, opts...); err != nil {
+			return report, fmt.Errorf("error upserting article %s: %w", request.URL, err)
+		}
+		report.Written = append(report.Written, request.URL)
+	}
+
+	return report, nil
+}
@@ -0,0 +1,60 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/0ffsideCompass/models"
+)
+
+func TestBulkUpsertArticlesLookupsIgnoreWriteOptions(t *testing.T) {
+	var lookups, writes atomic.Int64
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			lookups.Add(1)
+			if r.Header.Get("If-Match") != "" {
+				t.Error("lookup carried the If-Match precondition of the write")
+			}
+			if r.Header.Get("X-Trace") != "bulk" {
+				t.Errorf("lookup X-Trace = %q, want %q", r.Header.Get("X-Trace"), "bulk")
+			}
+			if r.URL.Query().Get("url") == "https://example.com/existing" {
+				writeJSON(w, http.StatusOK, `{"articles":[{"id":"1","title":"Existing","tags":["football"]}]}`)
+				return
+			}
+			writeJSON(w, http.StatusOK, `{"articles":[]}`)
+		case http.MethodPost:
+			io.Copy(io.Discard, r.Body)
+			writes.Add(1)
+			writeJSON(w, http.StatusCreated, `{}`)
+		}
+	}))
+
+	requests := []models.DataWarehouseCreateArticleRequest{
+		{Title: "Existing", URL: "https://example.com/existing", Tags: []string{"football"}},
+		{Title: "New", URL: "https://example.com/new"},
+	}
+	trace := func(o *requestOptions) { o.header.Set("X-Trace", "bulk") }
+	report, err := c.BulkUpsertArticles(requests, WithContext(context.Background()), WithReadAfterWriteRetry(5, time.Second), WithIfMatch(`"v1"`), trace)
+	if err != nil {
+		t.Fatalf("BulkUpsertArticles() error = %v", err)
+	}
+
+	if len(report.Unchanged) != 1 || report.Unchanged[0] != "https://example.com/existing" {
+		t.Errorf("Unchanged = %v, want the existing article", report.Unchanged)
+	}
+	if len(report.Written) != 1 || report.Written[0] != "https://example.com/new" {
+		t.Errorf("Written = %v, want the new article", report.Written)
+	}
+	if got := lookups.Load(); got != 2 {
+		t.Errorf("sent %d lookups, want 2 without read-after-write retries", got)
+	}
+	if got := writes.Load(); got != 1 {
+		t.Errorf("sent %d writes, want 1", got)
+	}
+}
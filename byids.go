@@ -0,0 +1,155 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/0ffsideCompass/models"
+)
+
+// ArticleResult holds the outcome of fetching a single article by ID: either Article or Err is set.
+type ArticleResult struct {
+	Article *models.Article
+	Err     error
+}
+
+// GetArticlesByIDs retrieves the articles with the given IDs concurrently, with at most concurrency
+// requests in flight. Duplicate IDs are fetched once. The articles are returned in the order of their
+// first ID. If any fetch fails the whole call fails; use GetArticlesByIDsPreservingErrors to keep the
+// articles that were retrieved.
+//
+// Parameters:
+//   - ctx: Context controlling cancellation of the requests
+//   - ids: IDs of the articles to retrieve
+//   - concurrency: Maximum number of concurrent requests
+//
+// Returns:
+//   - []models.Article: The articles in ID order
+//   - error: The error of the first ID, in ID order, whose fetch failed
+func (c *Client) GetArticlesByIDs(ctx context.Context, ids []string, concurrency int) ([]models.Article, error) {
+	results, err := c.GetArticlesByIDsPreservingErrors(ctx, ids, concurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	articles := make([]models.Article, 0, len(results))
+	for _, id := range uniqueIDs(ids) {
+		result := results[id]
+		if result.Err != nil {
+			return nil, fmt.Errorf("error getting article %s: %w", id, result.Err)
+		}
+		articles = append(articles, *result.Article)
+	}
+
+	return articles, nil
+}
+
+// GetArticlesByIDsPreservingErrors retrieves the articles with the given IDs concurrently, with at most
+// concurrency requests in flight, and reports the outcome of every ID separately so that one failure
+// does not discard the articles that were retrieved. Missing articles are reported as a *NotFoundError.
+// The requests also honor the client-wide WithMaxConcurrentRequests limit, and IDs not yet fetched when
+// ctx is done report ctx.Err().
+//
+// Parameters:
+//   - ctx: Context controlling cancellation of the requests
+//   - ids: IDs of the articles to retrieve
+//   - concurrency: Maximum number of concurrent requests
+//
+// Returns:
+//   - map[string]ArticleResult: The article or error of every distinct ID
+//   - error: An error if the arguments are invalid
+func (c *Client) GetArticlesByIDsPreservingErrors(ctx context.Context, ids []string, concurrency int) (map[string]ArticleResult, error) {
+	get := func(ctx context.Context, id string) (*models.Article, error) {
+		return c.GetArticle(id, WithContext(ctx))
+	}
+
+	results, err := getByIDs(ctx, ids, concurrency, get)
+	if err != nil {
+		return nil, err
+	}
+
+	articles := make(map[string]ArticleResult, len(results))
+	for id, result := range results {
+		articles[id] = ArticleResult{Article: result.value, Err: result.err}
+	}
+
+	return articles, nil
+}
+
+// idResult is the outcome of fetching one resource in getByIDs.
+type idResult[T any] struct {
+	value *T
+	err   error
+}
+
+// getByIDs calls get for every distinct ID in ids with at most concurrency calls in flight and
+// collects the outcome of each. Every call also honors the client-wide WithMaxConcurrentRequests
+// limit. Once ctx is done no further calls are started and the remaining IDs report ctx.Err().
+//
+// Parameters:
+//   - ctx: Context passed to every call
+//   - ids: IDs to fetch; empty IDs are rejected
+//   - concurrency: Maximum number of concurrent calls
+//   - get: Function fetching one resource
+//
+// Returns:
+//   - map[string]idResult[T]: The outcome of every distinct ID
+//   - error: An error if concurrency is less than 1 or an ID is empty
+func getByIDs[T any](ctx context.Context, ids []string, concurrency int, get func(context.Context, string) (*T, error)) (map[string]idResult[T], error) {
+	if concurrency < 1 {
+		return nil, errors.New("concurrency must be at least 1")
+	}
+
+	unique := uniqueIDs(ids)
+	for _, id := range unique {
+		if id == "" {
+			return nil, errEmptyID
+		}
+	}
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		results = make(map[string]idResult[T], len(unique))
+		sem     = make(chan struct{}, concurrency)
+	)
+	for _, id := range unique {
+		if err := acquireSlot(ctx, sem); err != nil {
+			mu.Lock()
+			results[id] = idResult[T]{err: err}
+			mu.Unlock()
+			continue
+		}
+
+		wg.Add(1)
+		go func(id string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			value, err := get(ctx, id)
+			mu.Lock()
+			defer mu.Unlock()
+			results[id] = idResult[T]{value: value, err: err}
+		}(id)
+	}
+	wg.Wait()
+
+	return results, nil
+}
+
+// uniqueIDs returns ids without duplicates, keeping the first occurrence of each.
+func uniqueIDs(ids []string) []string {
+	unique := make([]string, 0, len(ids))
+	seen := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+
+	return unique
+}
@@ -0,0 +1,76 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestGetArticlesByIDsHonorsClientLimit(t *testing.T) {
+	var inFlight, peak atomic.Int64
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(2 * time.Millisecond)
+		writeJSON(w, http.StatusOK, fmt.Sprintf(`{"article":{"id":%q}}`, path.Base(r.URL.Path)))
+	}), WithMaxConcurrentRequests(2))
+
+	ids := []string{"a", "b", "c", "d", "e", "f", "a"}
+	articles, err := c.GetArticlesByIDs(context.Background(), ids, 4)
+	if err != nil {
+		t.Fatalf("GetArticlesByIDs() error = %v", err)
+	}
+	if len(articles) != 6 {
+		t.Fatalf("got %d articles, want 6", len(articles))
+	}
+	for i, id := range []string{"a", "b", "c", "d", "e", "f"} {
+		if articles[i].ID != id {
+			t.Errorf("articles[%d].ID = %q, want %q", i, articles[i].ID, id)
+		}
+	}
+	if got := peak.Load(); got > 2 {
+		t.Errorf("peak concurrency = %d, want at most 2", got)
+	}
+}
+
+func TestGetArticlesByIDsStopsOnCancel(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	done := make(chan map[string]ArticleResult, 1)
+	go func() {
+		results, _ := c.GetArticlesByIDsPreservingErrors(ctx, []string{"a", "b", "c", "d"}, 1)
+		done <- results
+	}()
+
+	select {
+	case results := <-done:
+		for id, result := range results {
+			if !errors.Is(result.Err, context.DeadlineExceeded) {
+				t.Errorf("result of %s error = %v, want context.DeadlineExceeded", id, result.Err)
+			}
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("GetArticlesByIDsPreservingErrors() did not return after the context expired")
+	}
+}
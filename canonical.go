@@ -0,0 +1,42 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// CanonicalJSON encodes v as canonical JSON: object keys sorted at every level, including the fields
+// of structs, no insignificant whitespace, numbers kept exactly as encoded and HTML characters left
+// unescaped. Equivalent values therefore always produce byte-identical output, regardless of map
+// iteration order or struct field order, which is what signatures over request bodies require. Pass
+// it to WithJSONMarshaler to send canonical bodies.
+//
+// Parameters:
+//   - v: Value to encode
+//
+// Returns:
+//   - []byte: The canonical JSON encoding of v
+//   - error: An error if v cannot be encoded as JSON
+func CanonicalJSON(v interface{}) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var generic interface{}
+	if err := decoder.Decode(&generic); err != nil {
+		return nil, fmt.Errorf("error canonicalizing JSON: %w", err)
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(generic); err != nil {
+		return nil, fmt.Errorf("error canonicalizing JSON: %w", err)
+	}
+
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
@@ -0,0 +1,72 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestCanonicalJSONEquivalentInputs(t *testing.T) {
+	type inner struct {
+		Z int    `json:"z"`
+		A string `json:"a"`
+	}
+	type payload struct {
+		Title  string            `json:"title"`
+		Nested inner             `json:"nested"`
+		Extra  map[string]string `json:"extra"`
+	}
+
+	first := map[string]interface{}{"title": "<b>Derby</b> & co", "nested": map[string]interface{}{"a": "x", "z": 1}, "extra": map[string]string{"b": "2", "a": "1"}}
+	second := map[string]interface{}{"extra": map[string]string{"a": "1", "b": "2"}, "nested": map[string]interface{}{"z": 1, "a": "x"}, "title": "<b>Derby</b> & co"}
+	third := payload{Title: "<b>Derby</b> & co", Nested: inner{Z: 1, A: "x"}, Extra: map[string]string{"b": "2", "a": "1"}}
+
+	want := `{"extra":{"a":"1","b":"2"},"nested":{"a":"x","z":1},"title":"<b>Derby</b> & co"}`
+	for name, v := range map[string]interface{}{"map": first, "reordered map": second, "struct": third} {
+		got, err := CanonicalJSON(v)
+		if err != nil {
+			t.Fatalf("%s: CanonicalJSON() error = %v", name, err)
+		}
+		if string(got) != want {
+			t.Errorf("%s: CanonicalJSON() = %s, want %s", name, got, want)
+		}
+	}
+}
+
+func TestCanonicalJSONKeepsNumbersAndRemovesWhitespace(t *testing.T) {
+	got, err := CanonicalJSON(json.RawMessage(`{ "b" : [ 1.50, 9007199254740993 ],
+		"a" : { "y" : null, "x" : true } }`))
+	if err != nil {
+		t.Fatalf("CanonicalJSON() error = %v", err)
+	}
+	if want := `{"a":{"x":true,"y":null},"b":[1.50,9007199254740993]}`; string(got) != want {
+		t.Errorf("CanonicalJSON() = %s, want %s", got, want)
+	}
+}
+
+func TestCanonicalJSONAsMarshaler(t *testing.T) {
+	var bodies []string
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		writeJSON(w, http.StatusOK, `{}`)
+	}), WithJSONMarshaler(CanonicalJSON))
+
+	for _, payload := range []map[string]interface{}{
+		{"title": "Derby", "tags": []string{"football"}, "external_id": "ext-1"},
+		{"external_id": "ext-1", "title": "Derby", "tags": []string{"football"}},
+	} {
+		if err := c.PostInto(context.Background(), "/api/v1/articles", payload, &map[string]interface{}{}); err != nil {
+			t.Fatalf("PostInto() error = %v", err)
+		}
+	}
+
+	want := `{"external_id":"ext-1","tags":["football"],"title":"Derby"}`
+	for i, body := range bodies {
+		if body != want {
+			t.Errorf("body %d = %s, want %s", i, body, want)
+		}
+	}
+}
@@ -15,11 +15,19 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
+	"expvar"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"reflect"
+	"slices"
+	"time"
+
+	"golang.org/x/sync/singleflight"
 )
 
 // Client is a struct that encapsulates necessary details and methods to interact with the Data Warehouse microservice.
@@ -37,9 +45,63 @@ import (
 // The design of the Client struct emphasizes ease of use and flexibility, enabling developers to interact with the microservice
 // efficiently while maintaining high standards of security.
 type Client struct {
-	url    string
-	client *http.Client
-	apiKey string
+	url          string
+	client       *http.Client
+	apiKey       string
+	defaultTags  []string
+	marshal      func(interface{}) ([]byte, error)
+	retry        retryPolicy
+	retryBudget  *retryBudget
+	jitter       *jitterSource
+	onRetry      func(attempt int, err error, nextDelay time.Duration)
+	middleware   []Middleware
+	roundTrip    RoundTrip
+	endpoints    map[string]string
+	apiVersion   string
+	dump         *dumper
+	slowRequests *slowRequestWatcher
+	leakCheck    *bodyLeakChecker
+	tracer       *tracer
+	acceptStatus map[int]bool
+
+	defaultTimeout    time.Duration
+	perAttemptTimeout time.Duration
+	endpointTimeouts  map[string]time.Duration
+	redactedLogFields []string
+	name              string
+	responseValidator ResponseValidator
+	clock             func() time.Time
+	defaultQuery      url.Values
+	tagNormalizer     func(string) string
+	redirectPolicy    RedirectPolicy
+	articleChanged    ArticleChangeDetector
+	requestValidators []RequestValidator
+	bodyValidators    []RequestBodyValidator
+	inFlight          chan struct{}
+	replayDir         string
+	recordDir         string
+	transport         *http.Transport
+	resolver          *baseURLResolver
+	fieldNames        map[string]string
+
+	maxResponseBytes    int64
+	responseCompression bool
+	omitGetContentType  bool
+	discardResponseBody bool
+	expvarStats         *expvar.Map
+	errorDecoder        ErrorDecoder
+	singleFlight        *singleflight.Group
+	locale              string
+
+	requestCompressionMin int64
+	headers               headerTemplates
+	healthGate            *healthGate
+	asyncCreator          *asyncCreator
+	autoBatch             *autoBatch
+	interceptors          []RequestInterceptor
+	maxRequestBytes       int64
+	responseTransforms    []ResponseTransform
+	healthHistorySize     int
 }
 
 // New initializes and returns a new Client instance.
@@ -48,11 +110,12 @@ type Client struct {
 // Parameters:
 //   - url: Base URL of the API
 //   - apiKey: API key for authenticating requests
+//   - opts: Optional settings applied to the client in order
 //
 // Returns:
 //   - *Client: A pointer to the newly created Client instance
-//   - error: Error if the URL or API key are empty
-func New(url, apiKey string) (*Client, error) {
+//   - error: Error if the URL or API key are empty, or if an option is invalid
+func New(url, apiKey string, opts ...Option) (*Client, error) {
 	if url == "" {
 		return nil, errors.New("url is empty")
 	}
@@ -61,90 +124,534 @@ func New(url, apiKey string) (*Client, error) {
 		return nil, errors.New("apiKey is empty")
 	}
 
-	return &Client{
-		url:    url,
-		apiKey: apiKey,
-		client: &http.Client{},
-	}, nil
+	c := &Client{
+		url:     url,
+		apiKey:  apiKey,
+		client:  &http.Client{},
+		marshal: json.Marshal,
+	}
+
+	for _, opt := range opts {
+		if err := opt(c); err != nil {
+			return nil, fmt.Errorf("error applying option: %w", err)
+		}
+	}
+
+	if c.autoBatch != nil && c.asyncCreator == nil {
+		return nil, errors.New("WithAutoBatch requires WithAsyncCreates")
+	}
+	if c.healthHistorySize > 0 {
+		if c.healthGate == nil {
+			return nil, errors.New("WithHealthHistory requires WithHealthGate")
+		}
+		c.healthGate.history = &healthRing{samples: make([]HealthResponse, c.healthHistorySize)}
+	}
+
+	transport, err := c.buildTransport()
+	if err != nil {
+		return nil, fmt.Errorf("error configuring transport: %w", err)
+	}
+	c.client.Transport = transport
+	c.client.CheckRedirect = c.checkRedirect
+	c.roundTrip = c.buildRoundTrip()
+	c.headers = c.buildHeaderTemplates()
+	if c.healthGate != nil {
+		c.healthGate.start(c)
+	}
+	if c.asyncCreator != nil {
+		c.asyncCreator.start(c)
+	}
+
+	return c, nil
 }
 
 // get sends a GET request to the specified endpoint and returns the response body as a byte slice.
 // This function constructs the full URL by appending the endpoint to the base URL, sets up headers, and handles the HTTP response.
 //
 // Parameters:
+//   - ctx: Context controlling cancellation of the request
 //   - endpoint: API endpoint to send the GET request to
+//   - opts: Optional per-call settings such as extra headers
+//
+// Returns:
+//   - []byte: Response body as a byte slice
+//   - error: Error encountered during the request or response handling
+func (c *Client) get(ctx context.Context, endpoint string, opts ...RequestOption) ([]byte, error) {
+	return c.do(ctx, http.MethodGet, endpoint, nil, opts...)
+}
+
+// post sends a POST request with JSON data to the specified endpoint.
+// This function marshals the given data into JSON, constructs the request, sets necessary headers,
+// and processes the HTTP response.
+//
+// Parameters:
+//   - ctx: Context controlling cancellation of the request
+//   - endpoint: API endpoint to send the POST request to
+//   - data: Data to be sent as JSON in the request body
+//   - opts: Optional per-call settings such as extra headers
+//
+// Returns:
+//   - []byte: Response body as a byte slice
+//   - error: Error encountered during the request or response handling
+func (c *Client) post(ctx context.Context, endpoint string, data interface{}, opts ...RequestOption) ([]byte, error) {
+	return c.do(ctx, http.MethodPost, endpoint, data, opts...)
+}
+
+// put sends a PUT request with JSON data to the specified endpoint.
+// It behaves like post but is used for updates of existing resources.
+//
+// Parameters:
+//   - ctx: Context controlling cancellation of the request
+//   - endpoint: API endpoint to send the PUT request to
+//   - data: Data to be sent as JSON in the request body
+//   - opts: Optional per-call settings such as precondition headers
+//
+// Returns:
+//   - []byte: Response body as a byte slice
+//   - error: Error encountered during the request or response handling
+func (c *Client) put(ctx context.Context, endpoint string, data interface{}, opts ...RequestOption) ([]byte, error) {
+	return c.do(ctx, http.MethodPut, endpoint, data, opts...)
+}
+
+// patch sends a PATCH request with JSON data to the specified endpoint.
+// It behaves like put but is used for partial updates that only send the fields being changed.
+//
+// Parameters:
+//   - ctx: Context controlling cancellation of the request
+//   - endpoint: API endpoint to send the PATCH request to
+//   - data: Data to be sent as JSON in the request body
+//   - opts: Optional per-call settings such as precondition headers
+//
+// Returns:
+//   - []byte: Response body as a byte slice
+//   - error: Error encountered during the request or response handling
+func (c *Client) patch(ctx context.Context, endpoint string, data interface{}, opts ...RequestOption) ([]byte, error) {
+	return c.do(ctx, http.MethodPatch, endpoint, data, opts...)
+}
+
+// delete sends a DELETE request without a body to the specified endpoint.
+//
+// Parameters:
+//   - ctx: Context controlling cancellation of the request
+//   - endpoint: API endpoint to send the DELETE request to
+//   - opts: Optional per-call settings such as precondition headers
 //
 // Returns:
 //   - []byte: Response body as a byte slice
 //   - error: Error encountered during the request or response handling
-func (c *Client) get(endpoint string) ([]byte, error) {
-	url := fmt.Sprintf("%s%s", c.url, endpoint)
-	req, err := http.NewRequest(http.MethodGet, url, nil)
+func (c *Client) delete(ctx context.Context, endpoint string, opts ...RequestOption) ([]byte, error) {
+	return c.do(ctx, http.MethodDelete, endpoint, nil, opts...)
+}
+
+// do builds and sends a request with the given method to the specified endpoint.
+// When data is non-nil it is marshalled into JSON with the configured marshaler and sent as the request body.
+// Responses with a status not accepted by isSuccess are converted into typed errors by errorFromResponse.
+//
+// Parameters:
+//   - ctx: Context controlling cancellation of the request
+//   - method: HTTP method to use
+//   - endpoint: API endpoint to send the request to
+//   - data: Data to be sent as JSON in the request body, or nil for no body
+//   - opts: Optional per-call settings
+//
+// Returns:
+//   - []byte: Response body as a byte slice
+//   - error: Error encountered during the request or response handling
+func (c *Client) do(ctx context.Context, method, endpoint string, data interface{}, opts ...RequestOption) ([]byte, error) {
+	options := newRequestOptions(opts)
+	body, err := c.encodeBody(data, options.bodyOf)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.apiKey))
-
-	res, err := c.client.Do(req)
+	req, err := c.newRequest(ctx, method, endpoint, body, options)
 	if err != nil {
 		return nil, err
 	}
 
-	defer res.Body.Close()
-	body, err := io.ReadAll(res.Body)
+	req, err = c.compressRequest(req)
 	if err != nil {
 		return nil, err
 	}
 
-	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", res.StatusCode, string(body))
+	if c.coalesces(req, options) {
+		return c.executeShared(req, options)
 	}
 
-	return body, nil
+	return c.execute(req, options)
 }
 
-// post sends a POST request with JSON data to the specified endpoint.
-// This function marshals the given data into JSON, constructs the request, sets necessary headers,
-// and processes the HTTP response.
+// execute sends a prepared request and returns the response body of a successful response,
+// recording the call in the expvar metrics if WithExpvar is set.
 //
 // Parameters:
-//   - endpoint: API endpoint to send the POST request to
-//   - data: Data to be sent as JSON in the request body
+//   - req: The prepared request
+//   - options: Per-call settings of the request
+//
+// Returns:
+//   - []byte: Response body as a byte slice
+//   - error: Error encountered during the request or response handling
+func (c *Client) execute(req *http.Request, options *requestOptions) ([]byte, error) {
+	if err := c.checkHealthGate(options.operation); err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	body, err := c.exchange(req, options)
+	c.recordExpvar(options.operation, time.Since(start), err)
+
+	return body, err
+}
+
+// exchange sends a prepared request and returns the response body of a successful response.
+//
+// Parameters:
+//   - req: The prepared request
+//   - options: Per-call settings of the request
 //
 // Returns:
 //   - []byte: Response body as a byte slice
 //   - error: Error encountered during the request or response handling
-func (c *Client) post(endpoint string, data interface{}) ([]byte, error) {
-	jsonData, err := json.Marshal(data)
+func (c *Client) exchange(req *http.Request, options *requestOptions) ([]byte, error) {
+	req, cancel := c.withDefaultTimeout(req, options.operation)
+	defer cancel()
+
+	release, err := c.acquire(req.Context())
+	if err != nil {
+		return nil, fmt.Errorf("error waiting for a request slot: %w", err)
+	}
+	defer release()
+
+	res, err := c.send(req)
+	if err != nil {
+		return nil, fmt.Errorf("error sending request: %w", err)
+	}
+	defer res.Body.Close()
+
+	if c.discardsBody(options) && c.isSuccess(res.StatusCode, options) {
+		if err := drainBody(req.Context(), res.Body); err != nil {
+			return nil, fmt.Errorf("error reading response body: %w", err)
+		}
+		return nil, nil
+	}
+
+	body, err := c.responseBody(res)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+
+	resBody, err := readBody(req.Context(), body, c.maxResponseBytes)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+
+	if !c.isSuccess(res.StatusCode, options) {
+		return nil, c.errorFromResponse(res, resBody)
+	}
+
+	return c.transformResponse(req.URL.Path, resBody)
+}
+
+// isSuccess reports whether status is treated as success, either by default (200), through
+// WithAcceptStatus, or because the calling method accepts it for this request.
+func (c *Client) isSuccess(status int, options *requestOptions) bool {
+	if status == http.StatusOK || c.acceptStatus[status] {
+		return true
+	}
+
+	return slices.Contains(options.acceptStatus, status)
+}
+
+// readBody reads body to completion unless ctx is done first.
+// The read happens in a separate goroutine so a server that sends headers and then stalls the body
+// cannot hold the caller past its deadline; on cancellation the body is closed to unblock the reader
+// and ctx.Err(), such as context.DeadlineExceeded, is returned.
+//
+// Parameters:
+//   - ctx: Context bounding the read
+//   - body: The response body to read
+//   - limit: Maximum number of bytes to read, or zero for no limit
+//
+// Returns:
+//   - []byte: The body contents
+//   - error: The read error, ErrResponseTooLarge if the body exceeds limit, or ctx.Err() if the context finished first
+func readBody(ctx context.Context, body io.ReadCloser, limit int64) ([]byte, error) {
+	type result struct {
+		data []byte
+		err  error
+	}
+
+	done := make(chan result, 1)
+	go func() {
+		data, err := readAllLimited(body, limit)
+		done <- result{data: data, err: err}
+	}()
+
+	select {
+	case r := <-done:
+		return r.data, r.err
+	case <-ctx.Done():
+		body.Close()
+		return nil, ctx.Err()
+	}
+}
+
+// head sends a HEAD request to the specified endpoint and returns the response headers and status code.
+// The response body is never read. Unlike do, any status code is returned to the caller to interpret.
+// Servers are not required to support HEAD; callers should treat 405 Method Not Allowed and
+// 501 Not Implemented as a signal to fall back to an equivalent GET.
+//
+// Parameters:
+//   - ctx: Context controlling cancellation of the request
+//   - endpoint: API endpoint to send the HEAD request to
+//   - opts: Optional per-call settings
+//
+// Returns:
+//   - http.Header: Response headers
+//   - int: Response status code
+//   - error: Error encountered while building or sending the request
+func (c *Client) head(ctx context.Context, endpoint string, opts ...RequestOption) (http.Header, int, error) {
+	options := newRequestOptions(opts)
+	if err := c.checkHealthGate(options.operation); err != nil {
+		return nil, 0, err
+	}
+
+	req, err := c.newRequest(ctx, http.MethodHead, endpoint, nil, options)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	req, cancel := c.withDefaultTimeout(req, options.operation)
+	defer cancel()
+
+	release, err := c.acquire(req.Context())
+	if err != nil {
+		return nil, 0, fmt.Errorf("error waiting for a request slot: %w", err)
+	}
+	defer release()
+
+	res, err := c.send(req)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error sending request: %w", err)
+	}
+	res.Body.Close()
+
+	return res.Header, res.StatusCode, nil
+}
+
+// exists reports whether the resource at endpoint exists, using HEAD with a GET fallback.
+//
+// Parameters:
+//   - endpoint: API endpoint of the resource
+//   - opts: Optional per-call settings
+//
+// Returns:
+//   - bool: True if the resource exists
+//   - error: An error reporting issues in sending the request or an unexpected status code
+func (c *Client) exists(endpoint string, opts ...RequestOption) (bool, error) {
+	header, status, err := c.head(context.Background(), endpoint, opts...)
+	if err != nil {
+		return false, err
+	}
+
+	switch status {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	case http.StatusMethodNotAllowed, http.StatusNotImplemented:
+		_, err := c.get(context.Background(), endpoint, opts...)
+		var notFound *NotFoundError
+		if errors.As(asNotFound(err, "", ""), &notFound) {
+			return false, nil
+		}
+		return err == nil, err
+	default:
+		return false, c.errorFromResponse(&http.Response{StatusCode: status, Header: header}, nil)
+	}
+}
+
+// encodeBody marshals data into JSON with the configured marshaler. When resource is set, the encoded
+// bytes are checked by the request body validators before they are compressed and sent.
+//
+// Parameters:
+//   - data: Data to be sent as JSON, or nil for no body
+//   - resource: Request type of the body for the body validators, or empty to skip them
+//
+// Returns:
+//   - io.Reader: The encoded body, or nil when data is nil
+//   - error: Error encountered while marshalling, or the error of a failing body validator
+func (c *Client) encodeBody(data interface{}, resource string) (io.Reader, error) {
+	if data == nil {
+		return nil, nil
+	}
+
+	jsonData, err := c.marshal(data)
 	if err != nil {
 		return nil, fmt.Errorf("error marshalling data to JSON: %w", err)
 	}
+	if c.maxRequestBytes > 0 && int64(len(jsonData)) > c.maxRequestBytes {
+		return nil, fmt.Errorf("%w: %d bytes exceed the limit of %d", ErrRequestTooLarge, len(jsonData), c.maxRequestBytes)
+	}
+	if resource != "" {
+		if err := c.validateRequestBody(resource, jsonData); err != nil {
+			return nil, err
+		}
+	}
+
+	return bytes.NewBuffer(jsonData), nil
+}
+
+// newRequest builds an HTTP request for the specified endpoint.
+// Authentication headers and any per-call headers are applied. A context supplied with WithContext takes
+// precedence over ctx so methods without a context parameter can still be cancelled.
+//
+// Parameters:
+//   - ctx: Context controlling cancellation of the request
+//   - method: HTTP method to use
+//   - endpoint: API endpoint to send the request to
+//   - body: Request body, or nil for no body
+//   - options: Per-call settings
+//
+// Returns:
+//   - *http.Request: The prepared request
+//   - error: Error encountered while creating the request
+func (c *Client) newRequest(ctx context.Context, method, endpoint string, body io.Reader, options *requestOptions) (*http.Request, error) {
+	if options.ctx != nil {
+		ctx = options.ctx
+	}
+	if options.idempotent {
+		ctx = context.WithValue(ctx, idempotentKey{}, true)
+	}
 
-	url := fmt.Sprintf("%s%s", c.url, endpoint)
-	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(jsonData))
+	base, err := c.baseURL(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	url := fmt.Sprintf("%s%s", base, c.withDefaultQuery(withCallQuery(endpoint, options.query)))
+	req, err := http.NewRequestWithContext(c.withClientName(ctx), method, url, body)
 	if err != nil {
 		return nil, fmt.Errorf("error creating request: %w", err)
 	}
 
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.apiKey))
+	req.Header = c.baseHeader(method)
+	for key, values := range options.header {
+		req.Header[key] = values
+	}
+
+	return req, nil
+}
+
+// GetInto sends a GET request to endpoint and decodes the JSON response into target.
+// It is intended for endpoints whose response shape is not modelled by this package.
+//
+// Parameters:
+//   - ctx: Context controlling cancellation of the request
+//   - endpoint: API endpoint, relative to the base URL, to send the GET request to
+//   - target: Non-nil pointer the response is decoded into
+//
+// Returns:
+//   - error: An error if target is not a non-nil pointer, or if sending the request, handling the response or parsing the JSON fails
+func (c *Client) GetInto(ctx context.Context, endpoint string, target interface{}) error {
+	if err := checkTarget(target); err != nil {
+		return err
+	}
 
-	res, err := c.client.Do(req)
+	body, err := c.get(ctx, endpoint)
 	if err != nil {
-		return nil, fmt.Errorf("error sending request: %w", err)
+		return err
+	}
+
+	if err := decodeJSON(endpoint, body, target); err != nil {
+		return err
 	}
-	defer res.Body.Close()
 
-	body, err := io.ReadAll(res.Body)
+	return c.validateResponse(endpoint, target)
+}
+
+// PostInto sends body as JSON in a POST request to endpoint and decodes the JSON response into target.
+// It is intended for endpoints whose request or response shape is not modelled by this package.
+//
+// Parameters:
+//   - ctx: Context controlling cancellation of the request
+//   - endpoint: API endpoint, relative to the base URL, to send the POST request to
+//   - body: Data to be sent as JSON in the request body
+//   - target: Non-nil pointer the response is decoded into
+//
+// Returns:
+//   - error: An error if target is not a non-nil pointer, or if sending the request, handling the response or parsing the JSON fails
+func (c *Client) PostInto(ctx context.Context, endpoint string, body, target interface{}) error {
+	if err := checkTarget(target); err != nil {
+		return err
+	}
+
+	resBody, err := c.post(ctx, endpoint, body)
 	if err != nil {
-		return nil, fmt.Errorf("error reading response body: %w", err)
+		return err
 	}
 
-	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", res.StatusCode, string(body))
+	if err := decodeJSON(endpoint, resBody, target); err != nil {
+		return err
 	}
 
-	return body, nil
-}
\ No newline at end of file
+	return c.validateResponse(endpoint, target)
+}
+
+// decodeJSON unmarshals a response body into target.
+// Empty or whitespace-only bodies are reported as an *EmptyResponseError rather than the
+// confusing "unexpected end of JSON input" returned by encoding/json.
+//
+// Parameters:
+//   - endpoint: API endpoint the body was read from, used in error messages
+//   - body: The response body
+//   - target: Pointer the body is decoded into
+//
+// Returns:
+//   - error: An *EmptyResponseError for an empty body, or a *DecodeError if the JSON could not be parsed
+func decodeJSON(endpoint string, body []byte, target interface{}) error {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return &EmptyResponseError{Endpoint: endpoint}
+	}
+
+	if err := json.Unmarshal(body, target); err != nil {
+		return &DecodeError{Endpoint: endpoint, Body: truncate(string(body), maxErrorBodySnippet), Err: err}
+	}
+
+	return nil
+}
+
+// parse decodes a response body into a new value of type T and runs the client's response validator on it.
+// Every read method routes through parse (or decodeJSON for caller-supplied targets) so empty-body
+// detection and DecodeError reporting behave the same everywhere.
+//
+// Parameters:
+//   - c: Client whose response validator is applied
+//   - endpoint: API endpoint the body was read from, used in error messages
+//   - body: The response body
+//
+// Returns:
+//   - *T: The decoded value
+//   - error: An *EmptyResponseError for an empty body, a *DecodeError if the JSON could not be parsed, or a *ResponseValidationError if the validator rejected it
+func parse[T any](c *Client, endpoint string, body []byte) (*T, error) {
+	var value T
+	if err := decodeJSON(endpoint, body, &value); err != nil {
+		return nil, err
+	}
+
+	if err := c.validateResponse(endpoint, &value); err != nil {
+		return nil, err
+	}
+
+	return &value, nil
+}
+
+// checkTarget reports an error unless target is a non-nil pointer that json.Unmarshal can decode into.
+func checkTarget(target interface{}) error {
+	value := reflect.ValueOf(target)
+	if value.Kind() != reflect.Pointer || value.IsNil() {
+		return fmt.Errorf("target must be a non-nil pointer, got %T", target)
+	}
+
+	return nil
+}
@@ -0,0 +1,331 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/0ffsideCompass/models"
+)
+
+// newTestClient starts an httptest.Server serving handler and returns a Client configured against it.
+// The server and the client are closed when the test ends.
+func newTestClient(t testing.TB, handler http.Handler, opts ...Option) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	c, err := New(server.URL, "test-key", opts...)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	t.Cleanup(func() { c.Close() })
+
+	return c
+}
+
+// writeJSON writes body as a JSON response with the given status.
+func writeJSON(w http.ResponseWriter, status int, body string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write([]byte(body))
+}
+
+// testArticleRequest returns a valid article create request.
+func testArticleRequest() models.DataWarehouseCreateArticleRequest {
+	return models.DataWarehouseCreateArticleRequest{
+		ExternalID: "ext-1",
+		Title:      "Derby day",
+		URL:        "https://example.com/derby-day",
+		Tags:       []string{"football"},
+	}
+}
+
+func TestReadBodyAbortsStalledBody(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"status":`))
+		w.(http.Flusher).Flush()
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := c.GetHealth(WithContext(ctx))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("GetHealth() error = %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("GetHealth() returned after %s, want it to abort at the deadline", elapsed)
+	}
+}
+
+func TestReadBodyHonorsContext(t *testing.T) {
+	reader, writer := io.Pipe()
+	defer writer.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if _, err := readBody(ctx, reader, 0); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("readBody() error = %v, want context.DeadlineExceeded", err)
+	}
+	if _, err := writer.Write([]byte("late")); !errors.Is(err, io.ErrClosedPipe) {
+		t.Errorf("Write() after abort error = %v, want the body to be closed", err)
+	}
+}
+
+// readMethods calls every public read method that decodes a JSON response body.
+var readMethods = map[string]func(c *Client) error{
+	"GetHealth":               func(c *Client) error { _, err := c.GetHealth(); return err },
+	"GetArticle":              func(c *Client) error { _, err := c.GetArticle("a1"); return err },
+	"GetRawArticle":           func(c *Client) error { _, err := c.GetRawArticle("a1"); return err },
+	"GetArticleWithRelations": func(c *Client) error { _, err := c.GetArticleWithRelations("a1", []string{"author"}); return err },
+	"GetArticleByURL":         func(c *Client) error { _, err := c.GetArticleByURL("https://example.com/a"); return err },
+	"ListArticles":            func(c *Client) error { _, err := c.ListArticles(1, 10); return err },
+	"ListArticlesSorted":      func(c *Client) error { _, err := c.ListArticlesSorted("title", SortAsc, 1, 10); return err },
+	"GetArticlesModifiedSince": func(c *Client) error {
+		_, err := c.GetArticlesModifiedSince(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), 1, 10)
+		return err
+	},
+	"ListArticlesByCursor": func(c *Client) error { _, err := c.ListArticlesByCursor("", 10); return err },
+	"CountArticles":        func(c *Client) error { _, err := c.CountArticles(); return err },
+	"GetArticleStats":      func(c *Client) error { _, err := c.GetArticleStats(); return err },
+	"GetArticleTagCounts":  func(c *Client) error { _, err := c.GetArticleTagCounts(); return err },
+	"GetPodcast":           func(c *Client) error { _, err := c.GetPodcast("p1"); return err },
+	"GetRawPodcast":        func(c *Client) error { _, err := c.GetRawPodcast("p1"); return err },
+	"GetPodcastByURL":      func(c *Client) error { _, err := c.GetPodcastByURL("https://example.com/p"); return err },
+	"ListPodcasts":         func(c *Client) error { _, err := c.ListPodcasts(1, 10); return err },
+	"ListPodcastsSorted":   func(c *Client) error { _, err := c.ListPodcastsSorted("title", SortAsc, 1, 10); return err },
+	"GetPodcastsModifiedSince": func(c *Client) error {
+		_, err := c.GetPodcastsModifiedSince(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), 1, 10)
+		return err
+	},
+}
+
+// bodyErrorEndpoint returns the endpoint recorded in err if it is an *EmptyResponseError, for an empty
+// body, or a *DecodeError, for a malformed one.
+func bodyErrorEndpoint(err error, empty bool) (string, bool) {
+	if empty {
+		var emptyErr *EmptyResponseError
+		if !errors.As(err, &emptyErr) {
+			return "", false
+		}
+		return emptyErr.Endpoint, true
+	}
+
+	var decodeErr *DecodeError
+	if !errors.As(err, &decodeErr) {
+		return "", false
+	}
+	return decodeErr.Endpoint, true
+}
+
+func TestReadMethodsReportBodyErrors(t *testing.T) {
+	for name, read := range readMethods {
+		for _, body := range []struct {
+			name, body string
+			empty      bool
+		}{
+			{name: "empty", body: "", empty: true},
+			{name: "whitespace", body: "\n ", empty: true},
+			{name: "malformed", body: `{"status":`},
+		} {
+			t.Run(name+"/"+body.name, func(t *testing.T) {
+				var requested string
+				c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					requested = r.URL.RequestURI()
+					if r.URL.Query().Has("include") {
+						// Per-call query parameters such as WithInclude are not part of the endpoint.
+						requested = r.URL.Path
+					}
+					writeJSON(w, http.StatusOK, body.body)
+				}))
+
+				err := read(c)
+				endpoint, ok := bodyErrorEndpoint(err, body.empty)
+				if !ok {
+					t.Fatalf("%s() error = %v, want an error from decoding the body", name, err)
+				}
+				if endpoint != requested {
+					t.Errorf("Endpoint = %q, want the requested %q", endpoint, requested)
+				}
+			})
+		}
+	}
+}
+
+// testPodcastRequest returns a valid podcast create request.
+func testPodcastRequest() models.DataWarehouseCreatePodcastRequest {
+	return models.DataWarehouseCreatePodcastRequest{
+		ExternalID: "pod-1",
+		Title:      "Matchday",
+		URL:        "https://example.com/matchday",
+		Tags:       []string{"football"},
+	}
+}
+
+func TestIntoMethodsRejectInvalidTargets(t *testing.T) {
+	var requests atomic.Int64
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		writeJSON(w, http.StatusOK, `{}`)
+	}))
+
+	var nilMap *map[string]interface{}
+	for name, target := range map[string]interface{}{
+		"nil":         nil,
+		"non-pointer": map[string]interface{}{},
+		"typed nil":   nilMap,
+	} {
+		if err := c.GetInto(context.Background(), "/api/v1/custom", target); err == nil {
+			t.Errorf("%s: GetInto() error = nil, want an error", name)
+		}
+		if err := c.PostInto(context.Background(), "/api/v1/custom", map[string]string{"a": "b"}, target); err == nil {
+			t.Errorf("%s: PostInto() error = nil, want an error", name)
+		}
+	}
+	if got := requests.Load(); got != 0 {
+		t.Errorf("sent %d requests, want invalid targets to be rejected before sending", got)
+	}
+}
+
+func TestIntoMethodsDecodeIntoCallerStruct(t *testing.T) {
+	type standing struct {
+		Team   string `json:"team"`
+		Points int    `json:"points"`
+	}
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/standings" {
+			t.Errorf("path = %q, want /api/v1/standings", r.URL.Path)
+		}
+		if r.Method == http.MethodPost {
+			body, _ := io.ReadAll(r.Body)
+			if string(body) != `{"season":"2024"}` {
+				t.Errorf("request body = %s, want the JSON encoded body", body)
+			}
+		}
+		writeJSON(w, http.StatusOK, `{"team":"Arsenal","points":89}`)
+	}))
+
+	var got standing
+	if err := c.GetInto(context.Background(), "/api/v1/standings", &got); err != nil {
+		t.Fatalf("GetInto() error = %v", err)
+	}
+	if got != (standing{Team: "Arsenal", Points: 89}) {
+		t.Errorf("GetInto() decoded %+v", got)
+	}
+
+	got = standing{}
+	if err := c.PostInto(context.Background(), "/api/v1/standings", map[string]string{"season": "2024"}, &got); err != nil {
+		t.Fatalf("PostInto() error = %v", err)
+	}
+	if got != (standing{Team: "Arsenal", Points: 89}) {
+		t.Errorf("PostInto() decoded %+v", got)
+	}
+}
+
+func TestGetIntoReportsDecodeErrors(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, `{"points":"many"}`)
+	}))
+
+	var got struct {
+		Points int `json:"points"`
+	}
+	var decodeErr *DecodeError
+	if err := c.GetInto(context.Background(), "/api/v1/standings", &got); !errors.As(err, &decodeErr) {
+		t.Errorf("GetInto() error = %v, want *DecodeError", err)
+	}
+}
+
+func TestExistsFallsBackToGet(t *testing.T) {
+	tests := []struct {
+		name       string
+		headStatus int
+		getStatus  int
+		want       bool
+		wantGet    bool
+	}{
+		{name: "HEAD found", headStatus: http.StatusOK, want: true},
+		{name: "HEAD not found", headStatus: http.StatusNotFound, want: false},
+		{name: "405 then found", headStatus: http.StatusMethodNotAllowed, getStatus: http.StatusOK, want: true, wantGet: true},
+		{name: "405 then not found", headStatus: http.StatusMethodNotAllowed, getStatus: http.StatusNotFound, want: false, wantGet: true},
+		{name: "501 then found", headStatus: http.StatusNotImplemented, getStatus: http.StatusOK, want: true, wantGet: true},
+		{name: "501 then not found", headStatus: http.StatusNotImplemented, getStatus: http.StatusNotFound, want: false, wantGet: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for name, exists := range map[string]func(c *Client) (bool, error){
+				"ArticleExists": func(c *Client) (bool, error) { return c.ArticleExists("a1") },
+				"PodcastExists": func(c *Client) (bool, error) { return c.PodcastExists("p1") },
+			} {
+				var methods []string
+				c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					methods = append(methods, r.Method+" "+r.URL.Path)
+					if r.Method == http.MethodHead {
+						w.WriteHeader(tt.headStatus)
+						return
+					}
+					if tt.getStatus == http.StatusNotFound {
+						writeJSON(w, http.StatusNotFound, `{"error":"not found"}`)
+						return
+					}
+					writeJSON(w, tt.getStatus, `{}`)
+				}))
+
+				got, err := exists(c)
+				if err != nil {
+					t.Fatalf("%s() error = %v", name, err)
+				}
+				if got != tt.want {
+					t.Errorf("%s() = %v, want %v", name, got, tt.want)
+				}
+				if len(methods) == 0 || !strings.HasPrefix(methods[0], http.MethodHead+" ") {
+					t.Fatalf("%s requests = %q, want a HEAD first", name, methods)
+				}
+				wantRequests := 1
+				if tt.wantGet {
+					wantRequests = 2
+				}
+				if len(methods) != wantRequests {
+					t.Fatalf("%s requests = %q, want %d", name, methods, wantRequests)
+				}
+				if tt.wantGet && methods[1] != http.MethodGet+" "+strings.TrimPrefix(methods[0], http.MethodHead+" ") {
+					t.Errorf("%s fallback = %q, want a GET of %q", name, methods[1], methods[0])
+				}
+			}
+		})
+	}
+}
+
+func TestExistsReportsFallbackErrors(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodHead {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		writeJSON(w, http.StatusBadRequest, `{"error":"bad id"}`)
+	}))
+
+	var apiErr *APIError
+	if _, err := c.ArticleExists("a1"); !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadRequest {
+		t.Errorf("ArticleExists() error = %v, want the *APIError of the GET", err)
+	}
+}
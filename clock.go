@@ -0,0 +1,33 @@
+package client
+
+import (
+	"errors"
+	"time"
+)
+
+// WithClock replaces time.Now as the client's source of the current time, for example to make
+// incremental sync windows deterministic in tests.
+//
+// Parameters:
+//   - now: Function returning the current time
+//
+// Returns:
+//   - Option: Option setting the clock
+func WithClock(now func() time.Time) Option {
+	return func(c *Client) error {
+		if now == nil {
+			return errors.New("clock is nil")
+		}
+		c.clock = now
+		return nil
+	}
+}
+
+// now returns the current time according to the client's clock.
+func (c *Client) now() time.Time {
+	if c.clock != nil {
+		return c.clock()
+	}
+
+	return time.Now()
+}
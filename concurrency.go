@@ -0,0 +1,54 @@
+package client
+
+import (
+	"context"
+	"errors"
+)
+
+// WithMaxConcurrentRequests caps the number of requests the client has in flight at n. Further
+// requests wait for a free slot until their context is done, which also bounds the wait by
+// WithDefaultTimeout. A slot is held for the whole operation, including retries and reading the
+// response body. Long-lived streams opened by SubscribeArticleChanges and ConnectEvents are not counted.
+//
+// Parameters:
+//   - n: Maximum number of concurrent requests
+//
+// Returns:
+//   - Option: Option setting the concurrency limit
+func WithMaxConcurrentRequests(n int) Option {
+	return func(c *Client) error {
+		if n < 1 {
+			return errors.New("max concurrent requests must be at least 1")
+		}
+		c.inFlight = make(chan struct{}, n)
+		return nil
+	}
+}
+
+// acquire waits for a free request slot and returns a function releasing it.
+// Without a concurrency limit it returns immediately.
+func (c *Client) acquire(ctx context.Context) (func(), error) {
+	if c.inFlight == nil {
+		return func() {}, nil
+	}
+
+	select {
+	case c.inFlight <- struct{}{}:
+		return func() { <-c.inFlight }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// acquireSlot waits for a free slot in sem, a per-call limit of fan-out helpers such as
+// GetArticlesByIDs, unless ctx is done first. Requests made from the slot still wait for the
+// client-wide limit of WithMaxConcurrentRequests in acquire; taking that limit here as well would
+// deadlock once every client slot is held by a helper waiting for another.
+func acquireSlot(ctx context.Context, sem chan struct{}) error {
+	select {
+	case sem <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
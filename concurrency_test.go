@@ -0,0 +1,81 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMaxConcurrentRequestsCapsInFlight(t *testing.T) {
+	const limit = 3
+	var inFlight, peak atomic.Int64
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			old := peak.Load()
+			if n <= old || peak.CompareAndSwap(old, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		writeJSON(w, http.StatusOK, `{"status":"ok"}`)
+	}), WithMaxConcurrentRequests(limit))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 30; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.GetHealth(); err != nil {
+				t.Errorf("GetHealth() error = %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := peak.Load(); got > limit {
+		t.Errorf("peak in-flight requests = %d, want at most %d", got, limit)
+	}
+	if got := peak.Load(); got < 2 {
+		t.Errorf("peak in-flight requests = %d, want requests to run concurrently", got)
+	}
+}
+
+func TestMaxConcurrentRequestsHonorsContext(t *testing.T) {
+	release := make(chan struct{})
+	started := make(chan struct{}, 1)
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		started <- struct{}{}
+		<-release
+		writeJSON(w, http.StatusOK, `{"status":"ok"}`)
+	}), WithMaxConcurrentRequests(1))
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := c.GetHealth()
+		done <- err
+	}()
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := c.GetHealth(WithContext(ctx)); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("GetHealth() while saturated error = %v, want context.DeadlineExceeded", err)
+	}
+
+	close(release)
+	if err := <-done; err != nil {
+		t.Errorf("GetHealth() holding the slot error = %v", err)
+	}
+}
+
+func TestWithMaxConcurrentRequestsRejectsInvalidLimit(t *testing.T) {
+	if _, err := New("http://localhost", "test-key", WithMaxConcurrentRequests(0)); err == nil {
+		t.Error("New() error = nil, want an error for a zero limit")
+	}
+}
@@ -0,0 +1,61 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+const (
+	// totalCountHeader is the response header carrying the total number of items of a list endpoint.
+	totalCountHeader = "X-Total-Count"
+)
+
+// CountArticles returns the total number of articles by listing a single article with limit=1 and
+// reading the total from the pagination metadata. See CountArticlesFast for a cheaper variant.
+//
+// Parameters:
+//   - opts: Optional per-call settings such as WithContext
+//
+// Returns:
+//   - int: The total number of articles
+//   - error: An error object that reports issues either in sending the request, handling the response, or parsing the JSON
+func (c *Client) CountArticles(opts ...RequestOption) (int, error) {
+	response, err := c.ListArticles(1, 1, opts...)
+	if err != nil {
+		return 0, fmt.Errorf("error counting articles: %w", err)
+	}
+
+	return response.Total, nil
+}
+
+// CountArticlesFast returns the total number of articles without transferring any article data by
+// sending HEAD /api/v1/articles and reading the X-Total-Count response header. If the server does not
+// support HEAD on the endpoint (405 Method Not Allowed or 501 Not Implemented) or does not send a
+// usable X-Total-Count header, it falls back to CountArticles, which transfers one article.
+//
+// Parameters:
+//   - opts: Optional per-call settings such as WithContext
+//
+// Returns:
+//   - int: The total number of articles
+//   - error: An error reporting issues in sending the request, an unexpected status code, or an error from the fallback
+func (c *Client) CountArticlesFast(opts ...RequestOption) (int, error) {
+	header, status, err := c.head(context.Background(), c.endpoint(OperationCountArticles), append(opts, operation(OperationCountArticles))...)
+	if err != nil {
+		return 0, fmt.Errorf("error counting articles: %w", err)
+	}
+
+	switch status {
+	case http.StatusOK:
+		if total, err := strconv.Atoi(header.Get(totalCountHeader)); err == nil && total >= 0 {
+			return total, nil
+		}
+		return c.CountArticles(opts...)
+	case http.StatusMethodNotAllowed, http.StatusNotImplemented:
+		return c.CountArticles(opts...)
+	default:
+		return 0, fmt.Errorf("error counting articles: %w", c.errorFromResponse(&http.Response{StatusCode: status, Header: header}, nil))
+	}
+}
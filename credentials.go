@@ -0,0 +1,25 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// ValidateCredentials checks that the configured API key is accepted by making a cheap authenticated
+// read of a single article, so a misconfigured key surfaces at startup rather than on the first real
+// request. It has no side effects. Unlike GetHealth, which may be served without authentication, the
+// request always requires a valid key.
+//
+// Parameters:
+//   - ctx: Context controlling cancellation of the request
+//
+// Returns:
+//   - error: nil if the key is accepted, an *AuthError if it is rejected with 401 or 403, otherwise an error reporting issues in sending the request or handling the response
+func (c *Client) ValidateCredentials(ctx context.Context) error {
+	endpoint := pageQuery(c.endpoint(OperationValidateCredentials), 1, 1)
+	if _, err := c.get(ctx, endpoint, operation(OperationValidateCredentials)); err != nil {
+		return fmt.Errorf("error validating credentials: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/0ffsideCompass/models"
+)
+
+// DataWarehouse is the set of operations the Client exposes against the Data Warehouse microservice.
+// Code that depends on the Data Warehouse can accept this interface instead of *Client, which makes it
+// possible to substitute a mock in unit tests. *Client is the only implementation provided by this package.
+type DataWarehouse interface {
+	CreateArticle(request models.DataWarehouseCreateArticleRequest, opts ...RequestOption) error
+	BatchCreateArticles(requests []models.DataWarehouseCreateArticleRequest, opts ...RequestOption) error
+	CreateArticleAsync(request models.DataWarehouseCreateArticleRequest) error
+	Flush(ctx context.Context) error
+	BulkUpsertArticles(requests []models.DataWarehouseCreateArticleRequest, opts ...RequestOption) (*BulkUpsertReport, error)
+	GetOrCreateArticle(request models.DataWarehouseCreateArticleRequest, opts ...RequestOption) (*models.Article, bool, error)
+	StreamCreateArticles(ctx context.Context, r io.Reader, onProgress func(created int)) error
+	UpdateArticle(id string, request models.DataWarehouseCreateArticleRequest, opts ...RequestOption) error
+	RenameArticleTag(ctx context.Context, oldTag, newTag string) (int, error)
+	DeleteArticle(id string, opts ...RequestOption) error
+	GetArticle(id string, opts ...RequestOption) (*models.Article, error)
+	GetArticleWithRelations(id string, relations []string, opts ...RequestOption) (*ArticleWithRelations, error)
+	GetArticlesByIDs(ctx context.Context, ids []string, concurrency int) ([]models.Article, error)
+	GetArticlesByIDsPreservingErrors(ctx context.Context, ids []string, concurrency int) (map[string]ArticleResult, error)
+	GetRawArticle(id string, opts ...RequestOption) (json.RawMessage, error)
+	GetArticleResponse(id string, opts ...RequestOption) ([]byte, http.Header, int, error)
+	ArticleExists(id string, opts ...RequestOption) (bool, error)
+	GetArticleByURL(articleURL string, opts ...RequestOption) (*models.Article, error)
+	GetArticleTagCounts(opts ...RequestOption) (map[string]int, error)
+	GetArticleStats(opts ...RequestOption) (*ArticleStats, error)
+	ListArticles(page, limit int, opts ...RequestOption) (*PaginatedArticlesResponse, error)
+	CountArticles(opts ...RequestOption) (int, error)
+	CountArticlesFast(opts ...RequestOption) (int, error)
+	GetArticlesPage(ctx context.Context, page, limit int, opts ...RequestOption) (*ArticlesPage, error)
+	ListArticlesSorted(sortBy, order string, page, limit int, opts ...RequestOption) (*PaginatedArticlesResponse, error)
+	GetArticlesModifiedSince(since time.Time, page, limit int, opts ...RequestOption) (*PaginatedArticlesResponse, error)
+	FetchAllArticles(ctx context.Context, limit, concurrency int) ([]models.Article, error)
+	ListArticlesByCursor(cursor string, limit int, opts ...RequestOption) (*CursorArticlesResponse, error)
+	ExportArticles(ctx context.Context, w io.Writer) error
+	ExportArticlesResumable(ctx context.Context, w io.Writer, startCursor string) (string, error)
+	SubscribeArticleChanges(ctx context.Context) (<-chan ArticleEvent, error)
+	ConnectEvents(ctx context.Context) (*EventSubscription, error)
+	UploadArticleAttachment(id, filename string, r io.Reader, opts ...RequestOption) error
+	CreatePodcast(request models.DataWarehouseCreatePodcastRequest, opts ...RequestOption) error
+	UpdatePodcast(id string, request models.DataWarehouseCreatePodcastRequest, opts ...RequestOption) error
+	GetOrCreatePodcast(request models.DataWarehouseCreatePodcastRequest, opts ...RequestOption) (*models.Podcast, bool, error)
+	RenamePodcastTag(ctx context.Context, oldTag, newTag string) (int, error)
+	GetPodcast(id string, opts ...RequestOption) (*models.Podcast, error)
+	GetRawPodcast(id string, opts ...RequestOption) (json.RawMessage, error)
+	GetPodcastResponse(id string, opts ...RequestOption) ([]byte, http.Header, int, error)
+	PodcastExists(id string, opts ...RequestOption) (bool, error)
+	GetPodcastByURL(podcastURL string, opts ...RequestOption) (*models.Podcast, error)
+	ListPodcasts(page, limit int, opts ...RequestOption) (*PaginatedPodcastsResponse, error)
+	ListPodcastsSorted(sortBy, order string, page, limit int, opts ...RequestOption) (*PaginatedPodcastsResponse, error)
+	GetPodcastsModifiedSince(since time.Time, page, limit int, opts ...RequestOption) (*PaginatedPodcastsResponse, error)
+	FetchAllPodcasts(ctx context.Context, limit, concurrency int) ([]models.Podcast, error)
+	GetLatestContent(ctx context.Context, limit int) (*LatestContent, error)
+	GetHealth(opts ...RequestOption) (*HealthResponse, error)
+	HealthHistory() []HealthResponse
+	ValidateCredentials(ctx context.Context) error
+	Warmup(ctx context.Context, n int) error
+	Close() error
+	GetInto(ctx context.Context, endpoint string, target interface{}) error
+	PostInto(ctx context.Context, endpoint string, body, target interface{}) error
+}
+
+var _ DataWarehouse = (*Client)(nil)
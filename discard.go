@@ -0,0 +1,52 @@
+package client
+
+import (
+	"context"
+	"io"
+)
+
+// WithDiscardResponseBody makes CreateArticle and CreatePodcast skip reading the body of successful
+// responses into memory. The body is drained to io.Discard, so the connection can still be reused,
+// and never unmarshaled, which speeds up high-volume ingestion that does not need the echoed entity.
+// Error responses are still read so they can be reported as typed errors. Methods that return data,
+// such as PostInto, always read the body.
+//
+// Returns:
+//   - Option: Option enabling discarding create response bodies
+func WithDiscardResponseBody() Option {
+	return func(c *Client) error {
+		c.discardResponseBody = true
+		return nil
+	}
+}
+
+// bodyUnused marks a call whose caller ignores the response body, making it eligible for
+// WithDiscardResponseBody.
+func bodyUnused() RequestOption {
+	return func(o *requestOptions) {
+		o.bodyUnused = true
+	}
+}
+
+// discardsBody reports whether the body of a successful response to the call can be discarded.
+func (c *Client) discardsBody(options *requestOptions) bool {
+	return c.discardResponseBody && options.bodyUnused
+}
+
+// drainBody copies body to io.Discard unless ctx is done first, in which case the body is closed and
+// ctx.Err() is returned.
+func drainBody(ctx context.Context, body io.ReadCloser) error {
+	done := make(chan error, 1)
+	go func() {
+		_, err := io.Copy(io.Discard, body)
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		body.Close()
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,96 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"sync"
+)
+
+// WithRequestResponseDump writes the raw HTTP request and response of every attempt to w, which is
+// useful when attaching a wire capture to a support ticket. The Authorization header is redacted.
+//
+// This is a debugging aid: every request and response body is buffered in memory and written out
+// synchronously, which adds latency and allocations, so it should not be enabled in production traffic.
+// The dump runs innermost, after all middleware and request interceptors, so it reflects exactly what
+// is sent over the wire, including headers added by a signing interceptor.
+//
+// Parameters:
+//   - w: Writer receiving the dumps; writes are serialized by the client
+//
+// Returns:
+//   - Option: Option enabling request/response dumps
+func WithRequestResponseDump(w io.Writer) Option {
+	return func(c *Client) error {
+		if w == nil {
+			return errors.New("dump writer is nil")
+		}
+		c.dump = &dumper{w: w}
+		return nil
+	}
+}
+
+// dumper serializes request and response dumps to a writer.
+type dumper struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// wrap returns a RoundTrip dumping each exchange made through next.
+func (d *dumper) wrap(next RoundTrip) RoundTrip {
+	return func(req *http.Request) (*http.Response, error) {
+		d.writeRequest(req)
+
+		res, err := next(req)
+		if err != nil {
+			d.write(fmt.Appendf(nil, "error: %v\n\n", err))
+			return res, err
+		}
+
+		dump, dumpErr := httputil.DumpResponse(res, true)
+		if dumpErr != nil {
+			dump = fmt.Appendf(nil, "error dumping response: %v\n", dumpErr)
+		}
+		d.write(append(dump, '\n', '\n'))
+
+		return res, nil
+	}
+}
+
+// writeRequest dumps a copy of req with the Authorization header redacted, leaving req untouched.
+func (d *dumper) writeRequest(req *http.Request) {
+	redacted := req.Clone(req.Context())
+	if redacted.Header.Get("Authorization") != "" {
+		redacted.Header.Set("Authorization", "Bearer [REDACTED]")
+	}
+
+	withBody := true
+	switch {
+	case req.GetBody != nil:
+		body, err := req.GetBody()
+		if err != nil {
+			withBody = false
+		} else {
+			redacted.Body = body
+		}
+	case req.Body != nil && req.Body != http.NoBody:
+		// Streaming bodies cannot be dumped without consuming them.
+		withBody = false
+	}
+
+	dump, err := httputil.DumpRequestOut(redacted, withBody)
+	if err != nil {
+		dump = fmt.Appendf(nil, "error dumping request: %v\n", err)
+	}
+	d.write(append(dump, '\n', '\n'))
+}
+
+// write writes p to the underlying writer under the lock.
+func (d *dumper) write(p []byte) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.w.Write(p)
+}
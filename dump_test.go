@@ -0,0 +1,51 @@
+package client
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// syncBuffer is a bytes.Buffer safe for concurrent use.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func TestDumpShowsInterceptedRequest(t *testing.T) {
+	out := &syncBuffer{}
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, `{"article":{"id":"1","title":"Derby day"}}`)
+	}), WithRequestResponseDump(out), WithRequestInterceptor(func(req *http.Request) error {
+		req.Header.Set("X-Signature", "signed")
+		return nil
+	}))
+
+	if _, err := c.GetArticle("1"); err != nil {
+		t.Fatalf("GetArticle() error = %v", err)
+	}
+
+	dump := out.String()
+	for _, want := range []string{"GET /api/v1/articles/1", "X-Signature: signed", "Bearer [REDACTED]", `"title":"Derby day"`} {
+		if !strings.Contains(dump, want) {
+			t.Errorf("dump does not contain %q:\n%s", want, dump)
+		}
+	}
+	if strings.Contains(dump, "test-key") {
+		t.Errorf("dump contains the API key:\n%s", dump)
+	}
+}
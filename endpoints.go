@@ -0,0 +1,153 @@
+package client
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Logical operation names accepted by WithEndpointOverride.
+const (
+	OperationCreateArticle           = "createArticle"
+	OperationBatchCreateArticles     = "batchCreateArticles"
+	OperationUpdateArticle           = "updateArticle"
+	OperationPatchArticleTags        = "patchArticleTags"
+	OperationDeleteArticle           = "deleteArticle"
+	OperationGetArticle              = "getArticle"
+	OperationArticleExists           = "articleExists"
+	OperationGetArticleByURL         = "getArticleByURL"
+	OperationListArticles            = "listArticles"
+	OperationCountArticles           = "countArticles"
+	OperationGetArticleTagCounts     = "getArticleTagCounts"
+	OperationGetArticleStats         = "getArticleStats"
+	OperationUploadArticleAttachment = "uploadArticleAttachment"
+	OperationSubscribeArticleChanges = "subscribeArticleChanges"
+	OperationConnectEvents           = "connectEvents"
+	OperationCreatePodcast           = "createPodcast"
+	OperationUpdatePodcast           = "updatePodcast"
+	OperationPatchPodcastTags        = "patchPodcastTags"
+	OperationGetPodcast              = "getPodcast"
+	OperationPodcastExists           = "podcastExists"
+	OperationGetPodcastByURL         = "getPodcastByURL"
+	OperationListPodcasts            = "listPodcasts"
+	OperationGetHealth               = "getHealth"
+	OperationValidateCredentials     = "validateCredentials"
+)
+
+const (
+	// defaultAPIVersion is the API version the endpoint constants are written against.
+	defaultAPIVersion = "v1"
+)
+
+// supportedAPIVersions lists the versions accepted by WithAPIVersion.
+var supportedAPIVersions = map[string]bool{
+	"v1": true,
+	"v2": true,
+}
+
+// defaultEndpoints maps every logical operation to the endpoint it uses unless overridden.
+// Endpoints addressing a single resource contain an {id} placeholder.
+var defaultEndpoints = map[string]string{
+	OperationCreateArticle:           createArticleEndpoint,
+	OperationBatchCreateArticles:     batchArticlesEndpoint,
+	OperationUpdateArticle:           articleEndpoint,
+	OperationPatchArticleTags:        articleEndpoint,
+	OperationDeleteArticle:           articleEndpoint,
+	OperationGetArticle:              articleEndpoint,
+	OperationArticleExists:           articleEndpoint,
+	OperationGetArticleByURL:         createArticleEndpoint,
+	OperationListArticles:            createArticleEndpoint,
+	OperationCountArticles:           createArticleEndpoint,
+	OperationGetArticleTagCounts:     articleTagCountsEndpoint,
+	OperationGetArticleStats:         articleStatsEndpoint,
+	OperationUploadArticleAttachment: articleAttachmentEndpoint,
+	OperationSubscribeArticleChanges: articleStreamEndpoint,
+	OperationConnectEvents:           eventsEndpoint,
+	OperationCreatePodcast:           createPodcastEndpoint,
+	OperationUpdatePodcast:           podcastEndpoint,
+	OperationPatchPodcastTags:        podcastEndpoint,
+	OperationGetPodcast:              podcastEndpoint,
+	OperationPodcastExists:           podcastEndpoint,
+	OperationGetPodcastByURL:         createPodcastEndpoint,
+	OperationListPodcasts:            createPodcastEndpoint,
+	OperationGetHealth:               healthEndpoint,
+	OperationValidateCredentials:     createArticleEndpoint,
+}
+
+// WithEndpointOverride remaps logical operations to different endpoints, for example to point
+// OperationCreateArticle at "/api/v2/articles" in a staging environment without forking the client.
+// Keys must be one of the Operation constants. Paths must start with "/" and, for operations that
+// address a single resource, should contain an {id} placeholder that is replaced with the escaped ID.
+//
+// Parameters:
+//   - overrides: Map of logical operation name to endpoint path
+//
+// Returns:
+//   - Option: Option setting the endpoint overrides
+func WithEndpointOverride(overrides map[string]string) Option {
+	return func(c *Client) error {
+		for op, path := range overrides {
+			if _, ok := defaultEndpoints[op]; !ok {
+				return fmt.Errorf("unknown operation %q", op)
+			}
+			if path == "" {
+				return fmt.Errorf("endpoint override for %q is empty", op)
+			}
+			if !strings.HasPrefix(path, "/") {
+				return fmt.Errorf("endpoint override for %q must start with \"/\"", op)
+			}
+		}
+
+		if c.endpoints == nil {
+			c.endpoints = make(map[string]string, len(overrides))
+		}
+		for op, path := range overrides {
+			c.endpoints[op] = path
+		}
+		return nil
+	}
+}
+
+// WithAPIVersion pins the Data Warehouse API version used by the client.
+// The /api/v1/ prefix of every built-in endpoint is rewritten to the chosen version when requests are built,
+// so moving to a new version does not require new endpoint constants. The default is "v1".
+//
+// Supported versions:
+//   - v1: all article, podcast and health operations
+//   - v2: all article and podcast operations; the health endpoint is unversioned and unaffected
+//
+// Endpoints set with WithEndpointOverride are used verbatim and are not rewritten.
+//
+// Parameters:
+//   - version: API version, e.g. "v2"
+//
+// Returns:
+//   - Option: Option setting the API version
+func WithAPIVersion(version string) Option {
+	return func(c *Client) error {
+		if !supportedAPIVersions[version] {
+			return fmt.Errorf("unsupported api version %q", version)
+		}
+		c.apiVersion = version
+		return nil
+	}
+}
+
+// endpoint returns the endpoint configured for the logical operation op.
+func (c *Client) endpoint(op string) string {
+	if path, ok := c.endpoints[op]; ok {
+		return path
+	}
+
+	path := defaultEndpoints[op]
+	if c.apiVersion != "" && c.apiVersion != defaultAPIVersion {
+		path = strings.Replace(path, "/api/"+defaultAPIVersion+"/", "/api/"+c.apiVersion+"/", 1)
+	}
+
+	return path
+}
+
+// withID replaces the {id} placeholder in endpoint with the path-escaped id.
+func withID(endpoint, id string) string {
+	return strings.ReplaceAll(endpoint, "{id}", url.PathEscape(id))
+}
@@ -0,0 +1,46 @@
+package client
+
+import (
+	"errors"
+	"net/http"
+)
+
+// ErrorDecoder converts an error response into an error. It receives the status code and the response
+// body, which is empty for responses whose body is not read, such as HEAD responses. Returning nil
+// falls back to the built-in conversion.
+type ErrorDecoder func(statusCode int, body []byte) error
+
+// WithErrorDecoder converts error responses with decode instead of the built-in parsing of the
+// ErrorResponse and problem+json shapes, for servers whose error bodies use a different format.
+// The returned error is passed to the caller, wrapped with the method's context, so callers can
+// errors.As it into their own types. When decode returns nil the built-in conversion applies,
+// producing *APIError, *ConflictError, *RateLimitError, *GatewayError or ErrNoContent as usual; decode
+// can therefore handle just the shapes it recognizes. Methods that convert a 404 into a *NotFoundError
+// only do so for errors produced by the built-in conversion.
+//
+// Parameters:
+//   - decode: Function converting an error response into an error
+//
+// Returns:
+//   - Option: Option setting the error decoder
+func WithErrorDecoder(decode ErrorDecoder) Option {
+	return func(c *Client) error {
+		if decode == nil {
+			return errors.New("error decoder is nil")
+		}
+		c.errorDecoder = decode
+		return nil
+	}
+}
+
+// errorFromResponse converts a non-successful HTTP response into an error with the client's error
+// decoder, falling back to the built-in conversion of the package-level errorFromResponse.
+func (c *Client) errorFromResponse(res *http.Response, body []byte) error {
+	if c.errorDecoder != nil {
+		if err := c.errorDecoder(res.StatusCode, body); err != nil {
+			return err
+		}
+	}
+
+	return errorFromResponse(res, body)
+}
@@ -0,0 +1,387 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// maxErrorBodySnippet bounds how much of a response body is copied into error values.
+	maxErrorBodySnippet = 512
+)
+
+// errEmptyID is returned by methods addressing a single resource when no ID is given.
+var errEmptyID = errors.New("id is empty")
+
+// ErrNoContent is returned when the Data Warehouse answers a read with 204 No Content, meaning the
+// resource exists but has no body representation. It is distinct from *NotFoundError, which reports
+// that the resource does not exist.
+var ErrNoContent = errors.New("server returned no content")
+
+// ErrorResponse represents the JSON error body returned by the Data Warehouse.
+type ErrorResponse struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	Error   string `json:"error"`
+}
+
+// problemDetails represents an RFC 7807 application/problem+json body, as returned by some proxies.
+type problemDetails struct {
+	Type   string `json:"type"`
+	Title  string `json:"title"`
+	Status int    `json:"status"`
+	Detail string `json:"detail"`
+}
+
+// APIError is returned when the Data Warehouse responds with an unexpected status code.
+// It carries the status code and the raw response body so callers can inspect the failure. When the body
+// is JSON (application/json or application/problem+json), Code and Message are populated from it.
+type APIError struct {
+	StatusCode int
+	Code       string
+	Message    string
+	Body       string
+}
+
+// Error implements the error interface.
+func (e *APIError) Error() string {
+	if e.Code != "" || e.Message != "" {
+		return fmt.Sprintf("unexpected status code: %d, code: %s, message: %s", e.StatusCode, e.Code, e.Message)
+	}
+
+	return fmt.Sprintf("unexpected status code: %d, body: %s", e.StatusCode, e.Body)
+}
+
+// ConflictError is returned when a conditional request is rejected with 412 Precondition Failed, or a
+// write is rejected with 409 Conflict. A 412 means the resource was modified since the caller last read
+// it; callers should re-read the resource and retry with a fresh precondition. A 409 means the write
+// conflicts with the current state, for example because a concurrent writer created the resource first.
+type ConflictError struct {
+	StatusCode int
+	Body       string
+}
+
+// Error implements the error interface.
+func (e *ConflictError) Error() string {
+	if e.StatusCode == http.StatusConflict {
+		return fmt.Sprintf("conflict: status code: %d, body: %s", e.StatusCode, e.Body)
+	}
+
+	return fmt.Sprintf("precondition failed: status code: %d, body: %s", e.StatusCode, e.Body)
+}
+
+// AuthError is returned when the Data Warehouse rejects the API key with 401 Unauthorized or 403
+// Forbidden. Err holds the *APIError or, for non-JSON responses, the *GatewayError describing the
+// response, so errors.As still finds those types.
+type AuthError struct {
+	StatusCode int
+	Err        error
+}
+
+// Error implements the error interface.
+func (e *AuthError) Error() string {
+	return fmt.Sprintf("authentication failed: %v", e.Err)
+}
+
+// Unwrap returns the error describing the response.
+func (e *AuthError) Unwrap() error {
+	return e.Err
+}
+
+// RedirectError is returned for a redirect response that was not followed, as under RedirectNone.
+// Location holds the Location header of the response. Err holds the *APIError or, for non-JSON
+// responses such as the usual HTML redirect page, the *GatewayError describing the response, so
+// errors.As still finds those types.
+type RedirectError struct {
+	StatusCode int
+	Location   string
+	Err        error
+}
+
+// Error implements the error interface.
+func (e *RedirectError) Error() string {
+	return fmt.Sprintf("redirect not followed: status code: %d, location: %s", e.StatusCode, e.Location)
+}
+
+// Unwrap returns the error describing the response.
+func (e *RedirectError) Unwrap() error {
+	return e.Err
+}
+
+// RateLimitError is returned when the Data Warehouse rejects a request with 429 Too Many Requests.
+// RetryAfter is taken from the Retry-After header, given either in seconds or as an HTTP date, and
+// Reset from the X-RateLimit-Reset header, a Unix timestamp in seconds. Either is zero when the
+// corresponding header is missing or malformed.
+type RateLimitError struct {
+	StatusCode int
+	RetryAfter time.Duration
+	Reset      time.Time
+	Body       string
+}
+
+// Error implements the error interface.
+func (e *RateLimitError) Error() string {
+	switch {
+	case e.RetryAfter > 0:
+		return fmt.Sprintf("rate limited: status code: %d, retry after: %s", e.StatusCode, e.RetryAfter)
+	case !e.Reset.IsZero():
+		return fmt.Sprintf("rate limited: status code: %d, reset at: %s", e.StatusCode, e.Reset.Format(time.RFC3339))
+	default:
+		return fmt.Sprintf("rate limited: status code: %d", e.StatusCode)
+	}
+}
+
+// newRateLimitError builds a *RateLimitError from the rate limit headers of res.
+func newRateLimitError(res *http.Response, body []byte) *RateLimitError {
+	rateErr := &RateLimitError{StatusCode: res.StatusCode, Body: truncate(string(body), maxErrorBodySnippet)}
+
+	if value := res.Header.Get("Retry-After"); value != "" {
+		if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+			rateErr.RetryAfter = time.Duration(seconds) * time.Second
+		} else if date, err := http.ParseTime(value); err == nil {
+			rateErr.RetryAfter = max(time.Until(date), 0)
+		}
+	}
+
+	if value := res.Header.Get("X-RateLimit-Reset"); value != "" {
+		if seconds, err := strconv.ParseInt(value, 10, 64); err == nil && seconds > 0 {
+			rateErr.Reset = time.Unix(seconds, 0)
+		}
+	}
+
+	return rateErr
+}
+
+// GatewayError is returned when an error response is not JSON, typically the HTML error page of a
+// misconfigured proxy or load balancer answering in place of the Data Warehouse. Snippet holds the
+// beginning of the body so the page can be identified without flooding logs.
+type GatewayError struct {
+	StatusCode  int
+	ContentType string
+	Snippet     string
+}
+
+// Error implements the error interface.
+func (e *GatewayError) Error() string {
+	return fmt.Sprintf("unexpected non-JSON response: status code: %d, content type: %s, body: %s", e.StatusCode, e.ContentType, e.Snippet)
+}
+
+// NotFoundError is returned when the requested resource does not exist in the Data Warehouse.
+type NotFoundError struct {
+	Resource string
+	Key      string
+}
+
+// Error implements the error interface.
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("%s not found: %s", e.Resource, e.Key)
+}
+
+// EmptyEntityError is returned when a response decodes successfully but the entity it should carry
+// is missing, for example {"article": null}. It prevents methods from returning a nil pointer without
+// an error.
+type EmptyEntityError struct {
+	Resource string
+}
+
+// Error implements the error interface.
+func (e *EmptyEntityError) Error() string {
+	return fmt.Sprintf("server returned empty %s", e.Resource)
+}
+
+// EmptyResponseError is returned when a read succeeds but the Data Warehouse sends no response body.
+type EmptyResponseError struct {
+	Endpoint string
+}
+
+// Error implements the error interface.
+func (e *EmptyResponseError) Error() string {
+	return fmt.Sprintf("empty response body from %s", e.Endpoint)
+}
+
+// DecodeError is returned when a response body cannot be parsed as the expected JSON shape.
+// Body holds the beginning of the offending response to help diagnose contract drift.
+type DecodeError struct {
+	Endpoint string
+	Body     string
+	Err      error
+}
+
+// Error implements the error interface.
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("error unmarshalling response from %s: %v, body: %s", e.Endpoint, e.Err, e.Body)
+}
+
+// Unwrap returns the underlying JSON error.
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// truncate shortens s to at most n bytes, marking the cut with an ellipsis.
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+
+	return s[:n] + "..."
+}
+
+// Violation describes a single reason a request failed validation.
+type Violation struct {
+	Field   string
+	Message string
+}
+
+// ValidationError is returned when a request is rejected locally before being sent.
+// It lists every violation found so callers can fix the payload in one pass.
+type ValidationError struct {
+	Request    string
+	Violations []Violation
+}
+
+// Error implements the error interface.
+func (e *ValidationError) Error() string {
+	messages := make([]string, 0, len(e.Violations))
+	for _, v := range e.Violations {
+		messages = append(messages, fmt.Sprintf("%s: %s", v.Field, v.Message))
+	}
+
+	return fmt.Sprintf("invalid %s request: %s", e.Request, strings.Join(messages, "; "))
+}
+
+// asNotFound converts a 404 *APIError, or the *GatewayError of a 404 page from a proxy, into a
+// *NotFoundError for the given resource and key. Other errors are returned unchanged.
+func asNotFound(err error, resource, key string) error {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+		return &NotFoundError{Resource: resource, Key: key}
+	}
+
+	var gatewayErr *GatewayError
+	if errors.As(err, &gatewayErr) && gatewayErr.StatusCode == http.StatusNotFound {
+		return &NotFoundError{Resource: resource, Key: key}
+	}
+
+	return err
+}
+
+// errorFromResponse converts a non-successful HTTP response into a typed error.
+//
+// Parameters:
+//   - res: The HTTP response received from the Data Warehouse
+//   - body: The already read response body
+//
+// Returns:
+//   - error: ErrNoContent for 204 responses, a *ConflictError for 409 and 412 responses, a *RateLimitError for 429 responses, an *AuthError for 401 and 403 responses, a *RedirectError for redirect responses, a *GatewayError for responses with a non-JSON content type, otherwise an *APIError
+func errorFromResponse(res *http.Response, body []byte) error {
+	switch {
+	case res.StatusCode == http.StatusNoContent:
+		return ErrNoContent
+	case res.StatusCode == http.StatusPreconditionFailed, res.StatusCode == http.StatusConflict:
+		return &ConflictError{StatusCode: res.StatusCode, Body: truncate(string(body), maxErrorBodySnippet)}
+	case res.StatusCode == http.StatusTooManyRequests:
+		return newRateLimitError(res, body)
+	case res.StatusCode == http.StatusUnauthorized, res.StatusCode == http.StatusForbidden:
+		return &AuthError{StatusCode: res.StatusCode, Err: statusError(res, body)}
+	case res.StatusCode >= 300 && res.StatusCode < 400 && res.StatusCode != http.StatusNotModified:
+		return &RedirectError{StatusCode: res.StatusCode, Location: res.Header.Get("Location"), Err: statusError(res, body)}
+	default:
+		return statusError(res, body)
+	}
+}
+
+// statusError converts an error response without a more specific error type into a *GatewayError
+// for non-JSON content types or an *APIError otherwise.
+func statusError(res *http.Response, body []byte) error {
+	if isNonJSONContentType(res.Header.Get("Content-Type")) {
+		return &GatewayError{
+			StatusCode:  res.StatusCode,
+			ContentType: res.Header.Get("Content-Type"),
+			Snippet:     truncate(string(body), maxErrorBodySnippet),
+		}
+	}
+
+	return newAPIError(res, body)
+}
+
+// isNonJSONContentType reports whether contentType names a media type other than JSON, such as text/html.
+// A missing content type is not treated as non-JSON, since some servers omit it on error responses.
+func isNonJSONContentType(contentType string) bool {
+	if contentType == "" {
+		return false
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return true
+	}
+
+	return mediaType != "application/json" && !strings.HasSuffix(mediaType, "+json")
+}
+
+// newAPIError builds an *APIError, decoding Code and Message from JSON and problem+json bodies.
+// Bodies without a content type are kept only as raw text.
+//
+// Parameters:
+//   - res: The HTTP response received from the Data Warehouse
+//   - body: The already read response body
+//
+// Returns:
+//   - *APIError: The error describing the response
+func newAPIError(res *http.Response, body []byte) *APIError {
+	apiErr := &APIError{StatusCode: res.StatusCode, Body: string(body)}
+
+	mediaType, _, _ := mime.ParseMediaType(res.Header.Get("Content-Type"))
+	switch mediaType {
+	case "application/json":
+		var response ErrorResponse
+		if json.Unmarshal(body, &response) == nil {
+			apiErr.Code = response.Code
+			apiErr.Message = response.Message
+			if apiErr.Message == "" {
+				apiErr.Message = response.Error
+			}
+		}
+	case "application/problem+json":
+		var problem problemDetails
+		if json.Unmarshal(body, &problem) == nil {
+			apiErr.Code = problem.Type
+			apiErr.Message = problem.Detail
+			if apiErr.Message == "" {
+				apiErr.Message = problem.Title
+			}
+		}
+	}
+
+	return apiErr
+}
+
+// TagRenameError is returned by RenameArticleTag and RenamePodcastTag when some resources could not be
+// updated. Failed holds the error of every resource that still carries the old tag, keyed by ID.
+type TagRenameError struct {
+	Resource string
+	OldTag   string
+	NewTag   string
+	Failed   map[string]error
+}
+
+// Error implements the error interface.
+func (e *TagRenameError) Error() string {
+	return fmt.Sprintf("error renaming tag %s to %s: %d %ss could not be updated", e.OldTag, e.NewTag, len(e.Failed), e.Resource)
+}
+
+// Unwrap returns the errors of the failed updates, so errors.Is and errors.As can inspect them.
+func (e *TagRenameError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Failed))
+	for _, err := range e.Failed {
+		errs = append(errs, err)
+	}
+
+	return errs
+}
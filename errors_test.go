@@ -0,0 +1,165 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestNotFoundFromJSONAndHTML(t *testing.T) {
+	for name, write := range map[string]func(http.ResponseWriter){
+		"json": func(w http.ResponseWriter) { writeJSON(w, http.StatusNotFound, `{"error":"not found"}`) },
+		"html": func(w http.ResponseWriter) {
+			w.Header().Set("Content-Type", "text/html")
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte("<h1>404 Not Found</h1>"))
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { write(w) }))
+
+			_, err := c.GetArticle("missing")
+			var notFound *NotFoundError
+			if !errors.As(err, &notFound) {
+				t.Fatalf("GetArticle() error = %v, want *NotFoundError", err)
+			}
+			if notFound.Resource != "article" || notFound.Key != "missing" {
+				t.Errorf("NotFoundError = %+v", notFound)
+			}
+		})
+	}
+}
+
+func TestErrorTypesByStatus(t *testing.T) {
+	tests := []struct {
+		status int
+		check  func(error) bool
+	}{
+		{http.StatusConflict, func(err error) bool { var e *ConflictError; return errors.As(err, &e) }},
+		{http.StatusPreconditionFailed, func(err error) bool { var e *ConflictError; return errors.As(err, &e) }},
+		{http.StatusTooManyRequests, func(err error) bool { var e *RateLimitError; return errors.As(err, &e) }},
+		{http.StatusUnauthorized, func(err error) bool { var e *AuthError; return errors.As(err, &e) }},
+		{http.StatusForbidden, func(err error) bool { var e *AuthError; return errors.As(err, &e) }},
+		{http.StatusInternalServerError, func(err error) bool { var e *APIError; return errors.As(err, &e) }},
+		{http.StatusNoContent, func(err error) bool { return errors.Is(err, ErrNoContent) }},
+	}
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tt.status == http.StatusNoContent {
+					w.WriteHeader(tt.status)
+					return
+				}
+				writeJSON(w, tt.status, `{"error":"failed"}`)
+			}))
+
+			if _, err := c.GetArticle("1"); !tt.check(err) {
+				t.Errorf("GetArticle() error = %v (%T), wrong type for status %d", err, errors.Unwrap(err), tt.status)
+			}
+		})
+	}
+}
+
+func TestHTMLBadGatewayIsGatewayError(t *testing.T) {
+	page := "<html><body><h1>502 Bad Gateway</h1>" + strings.Repeat("<p>upstream unavailable</p>", 100) + "</body></html>"
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.WriteHeader(http.StatusBadGateway)
+		w.Write([]byte(page))
+	}))
+
+	_, err := c.GetArticle("1")
+	var gatewayErr *GatewayError
+	if !errors.As(err, &gatewayErr) {
+		t.Fatalf("GetArticle() error = %v, want *GatewayError", err)
+	}
+	if gatewayErr.StatusCode != http.StatusBadGateway {
+		t.Errorf("StatusCode = %d, want %d", gatewayErr.StatusCode, http.StatusBadGateway)
+	}
+	if gatewayErr.ContentType != "text/html; charset=utf-8" {
+		t.Errorf("ContentType = %q, want text/html; charset=utf-8", gatewayErr.ContentType)
+	}
+	if want := page[:maxErrorBodySnippet] + "..."; gatewayErr.Snippet != want {
+		t.Errorf("Snippet has %d bytes, want the first %d bytes of the page", len(gatewayErr.Snippet), maxErrorBodySnippet)
+	}
+
+	var decodeErr *DecodeError
+	var syntaxErr *json.SyntaxError
+	if errors.As(err, &decodeErr) || errors.As(err, &syntaxErr) {
+		t.Errorf("GetArticle() error = %v, want no JSON decoding error", err)
+	}
+}
+
+func TestConflictErrorTruncatesBody(t *testing.T) {
+	body := `{"error":"` + strings.Repeat("x", 4096) + `"}`
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusConflict, body)
+	}))
+
+	err := c.CreateArticle(testArticleRequest())
+	var conflict *ConflictError
+	if !errors.As(err, &conflict) {
+		t.Fatalf("CreateArticle() error = %v, want *ConflictError", err)
+	}
+	if want := body[:maxErrorBodySnippet] + "..."; conflict.Body != want {
+		t.Errorf("Body has %d bytes, want the first %d bytes of the response", len(conflict.Body), maxErrorBodySnippet)
+	}
+	if len(conflict.Error()) > 2*maxErrorBodySnippet {
+		t.Errorf("Error() has %d bytes, want the truncated body", len(conflict.Error()))
+	}
+}
+
+func TestProblemJSONErrorsAreDecoded(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		body        string
+		wantCode    string
+		wantMessage string
+	}{
+		{
+			name:        "400 with detail",
+			status:      http.StatusBadRequest,
+			body:        `{"type":"https://example.com/probs/invalid-url","title":"Invalid URL","status":400,"detail":"url must be absolute","instance":"/api/v1/articles"}`,
+			wantCode:    "https://example.com/probs/invalid-url",
+			wantMessage: "url must be absolute",
+		},
+		{
+			name:        "422 with title only",
+			status:      http.StatusUnprocessableEntity,
+			body:        `{"type":"https://example.com/probs/missing-tags","title":"Tags are required","status":422,"instance":"/api/v1/articles"}`,
+			wantCode:    "https://example.com/probs/missing-tags",
+			wantMessage: "Tags are required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/problem+json; charset=utf-8")
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			}))
+
+			err := c.CreateArticle(testArticleRequest())
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("CreateArticle() error = %v, want *APIError", err)
+			}
+			if apiErr.StatusCode != tt.status {
+				t.Errorf("StatusCode = %d, want %d", apiErr.StatusCode, tt.status)
+			}
+			if apiErr.Code != tt.wantCode {
+				t.Errorf("Code = %q, want the problem type %q", apiErr.Code, tt.wantCode)
+			}
+			if apiErr.Message != tt.wantMessage {
+				t.Errorf("Message = %q, want %q", apiErr.Message, tt.wantMessage)
+			}
+			if apiErr.Body != tt.body {
+				t.Errorf("Body = %q, want the raw problem document", apiErr.Body)
+			}
+		})
+	}
+}
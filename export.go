@@ -0,0 +1,115 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+
+	"github.com/0ffsideCompass/models"
+)
+
+const (
+	// exportPageSize is the number of articles requested per page by the export methods.
+	exportPageSize = 100
+)
+
+// CursorArticlesResponse represents one page of articles returned by ListArticlesByCursor.
+// NextCursor is empty on the last page.
+type CursorArticlesResponse struct {
+	Articles   []models.Article `json:"articles"`
+	NextCursor string           `json:"next_cursor"`
+}
+
+// ListArticlesByCursor retrieves one page of articles using cursor pagination, which unlike page numbers
+// stays stable while articles are added during a long read.
+//
+// Parameters:
+//   - cursor: Cursor returned with the previous page, or empty for the first page
+//   - limit: Maximum number of articles per page
+//   - opts: Optional per-call settings such as WithContext
+//
+// Returns:
+//   - *CursorArticlesResponse: The page of articles together with the cursor of the next page
+//   - error: An error object that reports issues either in sending the request, handling the response, or parsing the JSON
+func (c *Client) ListArticlesByCursor(cursor string, limit int, opts ...RequestOption) (*CursorArticlesResponse, error) {
+	if limit < 1 {
+		return nil, errors.New("limit must be at least 1")
+	}
+
+	values := url.Values{}
+	values.Set("limit", strconv.Itoa(limit))
+	if cursor != "" {
+		values.Set("cursor", cursor)
+	}
+
+	endpoint := c.endpoint(OperationListArticles) + "?" + values.Encode()
+	body, err := c.get(context.Background(), endpoint, append(opts, operation(OperationListArticles))...)
+	if err != nil {
+		return nil, fmt.Errorf("error listing articles by cursor: %w", err)
+	}
+
+	response, err := parse[CursorArticlesResponse](c, endpoint, body)
+	if err != nil {
+		return nil, fmt.Errorf("error listing articles by cursor: %w", err)
+	}
+
+	return response, nil
+}
+
+// ExportArticles writes every article to w as newline-delimited JSON, one article per line.
+//
+// Parameters:
+//   - ctx: Context controlling cancellation of the export
+//   - w: Writer receiving the NDJSON output
+//
+// Returns:
+//   - error: An error reporting issues in fetching a page or writing to w
+func (c *Client) ExportArticles(ctx context.Context, w io.Writer) error {
+	_, err := c.ExportArticlesResumable(ctx, w, "")
+	return err
+}
+
+// ExportArticlesResumable writes articles to w as newline-delimited JSON, starting at startCursor, so a
+// multi-hour export that is interrupted can be restarted where it stopped. Articles are written one
+// page at a time. When the export fails, the returned cursor is that of the page that could not be
+// completed: passing it back resumes the export without losing articles, although lines of that page
+// already written before a write error are written again.
+//
+// Parameters:
+//   - ctx: Context controlling cancellation of the export
+//   - w: Writer receiving the NDJSON output
+//   - startCursor: Cursor to resume from, or empty to export from the beginning
+//
+// Returns:
+//   - string: The cursor to resume from if the export failed, or empty once every article was written
+//   - error: An error reporting issues in fetching a page or writing to w
+func (c *Client) ExportArticlesResumable(ctx context.Context, w io.Writer, startCursor string) (string, error) {
+	encoder := json.NewEncoder(w)
+
+	cursor := startCursor
+	for {
+		if err := ctx.Err(); err != nil {
+			return cursor, fmt.Errorf("error exporting articles: %w", err)
+		}
+
+		page, err := c.ListArticlesByCursor(cursor, exportPageSize, WithContext(ctx))
+		if err != nil {
+			return cursor, fmt.Errorf("error exporting articles: %w", err)
+		}
+
+		for _, article := range page.Articles {
+			if err := encoder.Encode(article); err != nil {
+				return cursor, fmt.Errorf("error writing exported article: %w", err)
+			}
+		}
+
+		if page.NextCursor == "" {
+			return "", nil
+		}
+		cursor = page.NextCursor
+	}
+}
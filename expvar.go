@@ -0,0 +1,130 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"expvar"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// expvarLatencyBuckets are the upper bounds, in milliseconds, of the published latency histogram.
+var expvarLatencyBuckets = []float64{5, 10, 25, 50, 100, 250, 500, 1000, 2500, 5000, 10000}
+
+// expvarOtherOperation is the key under which calls without an Operation, such as GetInto, are counted.
+const expvarOtherOperation = "other"
+
+// WithExpvar publishes request counts, error counts and a latency histogram per operation through the
+// standard expvar package, so they are served on /debug/vars without any metrics dependency. The
+// metrics are published as a map named prefix holding one entry per Operation constant, with calls
+// without an operation, such as GetInto, counted under "other". Each entry holds requests, errors,
+// latency_ms_sum and latency_ms_buckets, a cumulative histogram keyed by upper bound in milliseconds.
+// Latency covers the whole call including retries. Clients configured with the same prefix share
+// their metrics.
+//
+// Parameters:
+//   - prefix: Name under which the metrics are published
+//
+// Returns:
+//   - Option: Option enabling expvar metrics
+func WithExpvar(prefix string) Option {
+	return func(c *Client) error {
+		if prefix == "" {
+			return errors.New("expvar prefix is empty")
+		}
+
+		expvarMu.Lock()
+		defer expvarMu.Unlock()
+
+		switch v := expvar.Get(prefix).(type) {
+		case nil:
+			stats := new(expvar.Map).Init()
+			expvar.Publish(prefix, stats)
+			c.expvarStats = stats
+		case *expvar.Map:
+			c.expvarStats = v
+		default:
+			return fmt.Errorf("expvar %s is already published with a different type", prefix)
+		}
+		return nil
+	}
+}
+
+// expvarMu serializes publishing expvar maps, since expvar.Publish panics on duplicate names.
+var expvarMu sync.Mutex
+
+// operationStats holds the published metrics of one operation.
+type operationStats struct {
+	mu       sync.Mutex
+	requests int64
+	errors   int64
+	sumMs    float64
+	buckets  []int64
+}
+
+// String implements expvar.Var, encoding the metrics as JSON.
+func (s *operationStats) String() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	buckets := make(map[string]int64, len(s.buckets))
+	for i, count := range s.buckets {
+		le := "+Inf"
+		if i < len(expvarLatencyBuckets) {
+			le = strconv.FormatFloat(expvarLatencyBuckets[i], 'f', -1, 64)
+		}
+		buckets[le] = count
+	}
+
+	data, _ := json.Marshal(map[string]interface{}{
+		"requests":           s.requests,
+		"errors":             s.errors,
+		"latency_ms_sum":     s.sumMs,
+		"latency_ms_buckets": buckets,
+	})
+	return string(data)
+}
+
+// observe records one call that took elapsed and failed if err is non-nil.
+func (s *operationStats) observe(elapsed time.Duration, err error) {
+	ms := float64(elapsed) / float64(time.Millisecond)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests++
+	if err != nil {
+		s.errors++
+	}
+	s.sumMs += ms
+	for i, le := range expvarLatencyBuckets {
+		if ms <= le {
+			s.buckets[i]++
+		}
+	}
+	s.buckets[len(expvarLatencyBuckets)]++
+}
+
+// recordExpvar records a call of op in the published metrics, if WithExpvar is set.
+func (c *Client) recordExpvar(op string, elapsed time.Duration, err error) {
+	if c.expvarStats == nil {
+		return
+	}
+	if op == "" {
+		op = expvarOtherOperation
+	}
+
+	stats, ok := c.expvarStats.Get(op).(*operationStats)
+	if !ok {
+		expvarMu.Lock()
+		if stats, ok = c.expvarStats.Get(op).(*operationStats); !ok {
+			stats = &operationStats{buckets: make([]int64, len(expvarLatencyBuckets)+1)}
+			c.expvarStats.Set(op, stats)
+		}
+		expvarMu.Unlock()
+	}
+
+	stats.observe(elapsed, err)
+}
@@ -0,0 +1,84 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// fieldMappedBody is a request body whose top-level JSON keys are renamed when it is marshalled.
+type fieldMappedBody struct {
+	value   interface{}
+	marshal func(interface{}) ([]byte, error)
+	names   map[string]string
+}
+
+// WithFieldNameMapping renames top-level JSON keys of outgoing article and podcast create and update
+// requests, for example {"url": "page_url"} for a server variant that expects a different field name.
+// This is an advanced interoperability feature for integrating with slightly different server
+// versions without forking the models. The request is first encoded as usual, including with a
+// marshaler set by WithJSONMarshaler, and its keys are renamed afterwards. Validators registered with
+// WithRequestValidator still see the models, while request body validators such as
+// schema.WithRequestSchemaValidation check the renamed fields; responses are not affected.
+//
+// Parameters:
+//   - names: Map of JSON field name in the models to the name sent to the server
+//
+// Returns:
+//   - Option: Option setting the field name mapping
+func WithFieldNameMapping(names map[string]string) Option {
+	return func(c *Client) error {
+		targets := make(map[string]string, len(names))
+		for from, to := range names {
+			if from == "" || to == "" {
+				return errors.New("field names must not be empty")
+			}
+			if other, ok := targets[to]; ok {
+				return fmt.Errorf("fields %q and %q are both mapped to %q", other, from, to)
+			}
+			targets[to] = from
+		}
+
+		c.fieldNames = make(map[string]string, len(names))
+		for from, to := range names {
+			c.fieldNames[from] = to
+		}
+		return nil
+	}
+}
+
+// mapFields returns request wrapped so that its fields are renamed according to WithFieldNameMapping
+// when it is encoded. Without a mapping request is returned unchanged.
+func (c *Client) mapFields(request interface{}) interface{} {
+	if len(c.fieldNames) == 0 {
+		return request
+	}
+
+	return &fieldMappedBody{value: request, marshal: c.marshal, names: c.fieldNames}
+}
+
+// MarshalJSON encodes the wrapped value and renames its top-level keys.
+func (b *fieldMappedBody) MarshalJSON() ([]byte, error) {
+	data, err := b.marshal(b.value)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, fmt.Errorf("error remapping field names: %w", err)
+	}
+
+	mapped := make(map[string]json.RawMessage, len(fields))
+	for name, value := range fields {
+		if to, ok := b.names[name]; ok {
+			name = to
+		}
+		if _, ok := mapped[name]; ok {
+			return nil, fmt.Errorf("error remapping field names: duplicate field %q", name)
+		}
+		mapped[name] = value
+	}
+
+	return json.Marshal(mapped)
+}
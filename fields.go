@@ -0,0 +1,31 @@
+package client
+
+import (
+	"net/url"
+	"strings"
+)
+
+// WithFields asks the server to return only the given fields of the resources in the response, for
+// example WithFields("id", "title"), by setting the fields query parameter. This cuts the payload of
+// reads such as GetArticle, ListArticles and list views that only show a few columns. The response is
+// decoded into the usual model with every field that was not requested left at its zero value, so
+// callers must only rely on the fields they asked for; an empty Tags slice, for instance, does not mean
+// the article has no tags. Field names are the JSON names of the model. Servers that do not support
+// field selection ignore the parameter and return complete resources.
+//
+// Parameters:
+//   - fields: JSON names of the fields to return
+//
+// Returns:
+//   - RequestOption: Option setting the fields query parameter
+func WithFields(fields ...string) RequestOption {
+	return func(o *requestOptions) {
+		if len(fields) == 0 {
+			return
+		}
+		if o.query == nil {
+			o.query = url.Values{}
+		}
+		o.query["fields"] = []string{strings.Join(fields, ",")}
+	}
+}
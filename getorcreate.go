@@ -0,0 +1,97 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/0ffsideCompass/models"
+)
+
+// GetOrCreateArticle returns the article stored under request.URL, creating it from request if none
+// exists yet. If another writer creates the article between the lookup and the create and the server
+// rejects the create with 409 Conflict, the article is read again and returned as found. The article
+// created is read back by URL, so pass WithReadAfterWriteRetry if the Data Warehouse replicates writes
+// with a delay; the first lookup only receives the context and headers of opts, so it is not retried.
+//
+// Parameters:
+//   - request: CreateArticleRequest describing the article; its URL identifies the article
+//   - opts: Optional per-call settings applied to the create and the reads after it, such as WithContext
+//
+// Returns:
+//   - *models.Article: The existing or created article
+//   - bool: True if the article was created by this call
+//   - error: An error reporting issues in looking up or creating the article
+func (c *Client) GetOrCreateArticle(request models.DataWarehouseCreateArticleRequest, opts ...RequestOption) (*models.Article, bool, error) {
+	if request.URL == "" {
+		return nil, false, errors.New("error getting or creating article: url is empty")
+	}
+
+	lookupOpts := lookupOptions(opts)
+	lookup := func() (*models.Article, error) { return c.GetArticleByURL(request.URL, lookupOpts...) }
+	create := func() error { return c.CreateArticle(request, opts...) }
+	readBack := func() (*models.Article, error) { return c.GetArticleByURL(request.URL, opts...) }
+
+	article, created, err := getOrCreate(lookup, create, readBack)
+	if err != nil {
+		return nil, false, fmt.Errorf("error getting or creating article %s: %w", request.URL, err)
+	}
+
+	return article, created, nil
+}
+
+// GetOrCreatePodcast returns the podcast stored under request.URL, creating it from request if none
+// exists yet. It behaves like GetOrCreateArticle.
+//
+// Parameters:
+//   - request: CreatePodcastRequest describing the podcast; its URL identifies the podcast
+//   - opts: Optional per-call settings applied to the create and the reads after it, such as WithContext
+//
+// Returns:
+//   - *models.Podcast: The existing or created podcast
+//   - bool: True if the podcast was created by this call
+//   - error: An error reporting issues in looking up or creating the podcast
+func (c *Client) GetOrCreatePodcast(request models.DataWarehouseCreatePodcastRequest, opts ...RequestOption) (*models.Podcast, bool, error) {
+	if request.URL == "" {
+		return nil, false, errors.New("error getting or creating podcast: url is empty")
+	}
+
+	lookupOpts := lookupOptions(opts)
+	lookup := func() (*models.Podcast, error) { return c.GetPodcastByURL(request.URL, lookupOpts...) }
+	create := func() error { return c.CreatePodcast(request, opts...) }
+	readBack := func() (*models.Podcast, error) { return c.GetPodcastByURL(request.URL, opts...) }
+
+	podcast, created, err := getOrCreate(lookup, create, readBack)
+	if err != nil {
+		return nil, false, fmt.Errorf("error getting or creating podcast %s: %w", request.URL, err)
+	}
+
+	return podcast, created, nil
+}
+
+// getOrCreate looks a resource up, creates it if the lookup reports it missing, and reads it back
+// with readBack. A create rejected with a *ConflictError means a concurrent writer created it first,
+// so it is read back and reported as not created.
+func getOrCreate[T any](lookup func() (*T, error), create func() error, readBack func() (*T, error)) (*T, bool, error) {
+	existing, err := lookup()
+	var notFound *NotFoundError
+	if !errors.As(err, &notFound) {
+		return existing, false, err
+	}
+
+	err = create()
+	var conflict *ConflictError
+	if errors.As(err, &conflict) {
+		existing, err := readBack()
+		return existing, false, err
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	created, err := readBack()
+	if err != nil {
+		return nil, false, err
+	}
+
+	return created, true, nil
+}
@@ -0,0 +1,63 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestGetOrCreateArticleRetriesOnlyReadBack(t *testing.T) {
+	var lookups, created atomic.Int64
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			io.Copy(io.Discard, r.Body)
+			created.Add(1)
+			writeJSON(w, http.StatusCreated, `{}`)
+			return
+		}
+		// The created article becomes visible on the second read after the create.
+		if n := lookups.Add(1); created.Load() == 0 || n < 3 {
+			writeJSON(w, http.StatusOK, `{"articles":[]}`)
+			return
+		}
+		writeJSON(w, http.StatusOK, `{"articles":[{"id":"1","title":"Derby day"}]}`)
+	}))
+
+	article, wasCreated, err := c.GetOrCreateArticle(testArticleRequest(), WithContext(context.Background()), WithReadAfterWriteRetry(3, time.Millisecond))
+	if err != nil {
+		t.Fatalf("GetOrCreateArticle() error = %v", err)
+	}
+	if !wasCreated || article.ID != "1" {
+		t.Errorf("GetOrCreateArticle() = %+v, %v, want the created article", article, wasCreated)
+	}
+	if got := lookups.Load(); got != 3 {
+		t.Errorf("sent %d lookups, want 3: one before the create and two reading it back", got)
+	}
+}
+
+func TestGetOrCreateArticleConflict(t *testing.T) {
+	var lookups atomic.Int64
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			io.Copy(io.Discard, r.Body)
+			writeJSON(w, http.StatusConflict, `{"error":"exists"}`)
+			return
+		}
+		if lookups.Add(1) == 1 {
+			writeJSON(w, http.StatusOK, `{"articles":[]}`)
+			return
+		}
+		writeJSON(w, http.StatusOK, `{"articles":[{"id":"2"}]}`)
+	}))
+
+	article, wasCreated, err := c.GetOrCreateArticle(testArticleRequest())
+	if err != nil {
+		t.Fatalf("GetOrCreateArticle() error = %v", err)
+	}
+	if wasCreated || article.ID != "2" {
+		t.Errorf("GetOrCreateArticle() = %+v, %v, want the concurrently created article", article, wasCreated)
+	}
+}
@@ -0,0 +1,42 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// headerTemplates holds the static headers of every request, computed once by New so that building
+// a request clones a ready map instead of formatting and setting each header again.
+type headerTemplates struct {
+	withContentType    http.Header
+	withoutContentType http.Header
+}
+
+// buildHeaderTemplates computes the static request headers from the client's configuration. It must
+// run after all options are applied.
+func (c *Client) buildHeaderTemplates() headerTemplates {
+	base := http.Header{}
+	base.Set("Authorization", fmt.Sprintf("Bearer %s", c.apiKey))
+	base.Set("User-Agent", c.userAgentHeader())
+	if c.locale != "" {
+		base.Set("Accept-Language", c.locale)
+	}
+	if c.responseCompression {
+		base.Set("Accept-Encoding", "gzip")
+	}
+
+	withContentType := base.Clone()
+	withContentType.Set("Content-Type", "application/json")
+
+	return headerTemplates{withContentType: withContentType, withoutContentType: base}
+}
+
+// baseHeader returns a copy of the static headers of a request with the given method, which the caller
+// may modify.
+func (c *Client) baseHeader(method string) http.Header {
+	if c.sendsContentType(method) {
+		return c.headers.withContentType.Clone()
+	}
+
+	return c.headers.withoutContentType.Clone()
+}
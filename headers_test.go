@@ -0,0 +1,93 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestBaseHeadersOnTheWire(t *testing.T) {
+	var headers []http.Header
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = append(headers, r.Header.Clone())
+		if r.Method == http.MethodPost {
+			writeJSON(w, http.StatusCreated, `{}`)
+			return
+		}
+		writeJSON(w, http.StatusOK, `{"status":"ok"}`)
+	}), WithGetContentType(false), WithResponseCompression())
+
+	if err := c.CreateArticle(testArticleRequest(), WithIfMatch(`"v1"`)); err != nil {
+		t.Fatalf("CreateArticle() error = %v", err)
+	}
+	if _, err := c.GetHealth(); err != nil {
+		t.Fatalf("GetHealth() error = %v", err)
+	}
+
+	post, get := headers[0], headers[1]
+	for _, h := range []http.Header{post, get} {
+		if got := h.Get("Authorization"); got != "Bearer test-key" {
+			t.Errorf("Authorization = %q, want %q", got, "Bearer test-key")
+		}
+		if got := h.Get("User-Agent"); got != c.userAgentHeader() {
+			t.Errorf("User-Agent = %q, want %q", got, c.userAgentHeader())
+		}
+		if got := h.Get("Accept-Encoding"); got != "gzip" {
+			t.Errorf("Accept-Encoding = %q, want gzip", got)
+		}
+	}
+	if got := post.Get("Content-Type"); got != "application/json" {
+		t.Errorf("POST Content-Type = %q, want application/json", got)
+	}
+	if got := get.Get("Content-Type"); got != "" {
+		t.Errorf("GET Content-Type = %q, want none", got)
+	}
+	if got := get.Get("If-Match"); got != "" {
+		t.Errorf("GET If-Match = %q, want the previous call's header not to leak", got)
+	}
+}
+
+func TestBaseHeaderReturnsCopy(t *testing.T) {
+	c, err := New("http://localhost", "test-key")
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer c.Close()
+
+	header := c.baseHeader(http.MethodPost)
+	header.Set("Authorization", "Bearer other")
+	header.Set("X-Extra", "1")
+
+	if got := c.baseHeader(http.MethodPost); got.Get("Authorization") != "Bearer test-key" || got.Get("X-Extra") != "" {
+		t.Errorf("baseHeader() = %v, want the template unchanged", got)
+	}
+}
+
+// headerSink keeps benchmarked headers reachable so they are allocated as they would be for a request.
+var headerSink http.Header
+
+func BenchmarkRequestHeader(b *testing.B) {
+	c, err := New("http://localhost", "test-key", WithLocale("en-GB"))
+	if err != nil {
+		b.Fatalf("New() error = %v", err)
+	}
+	defer c.Close()
+
+	b.Run("build", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			header := http.Header{}
+			header.Set("Authorization", fmt.Sprintf("Bearer %s", c.apiKey))
+			header.Set("User-Agent", c.userAgentHeader())
+			header.Set("Accept-Language", c.locale)
+			header.Set("Content-Type", "application/json")
+			headerSink = header
+		}
+	})
+	b.Run("template", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			headerSink = c.baseHeader(http.MethodPost)
+		}
+	})
+}
@@ -0,0 +1,70 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	healthEndpoint = "/health"
+)
+
+// healthyStatuses lists the component statuses, compared case-insensitively, that count as healthy.
+var healthyStatuses = map[string]bool{
+	"ok":      true,
+	"up":      true,
+	"healthy": true,
+}
+
+// HealthResponse represents the health status reported by the Data Warehouse.
+// Components holds the status of individual subsystems, such as "db", "cache" or "queue", when the
+// server reports them; it is nil for servers that only report Database.
+type HealthResponse struct {
+	Status     string            `json:"status"`
+	Database   string            `json:"database"`
+	Components map[string]string `json:"components,omitempty"`
+}
+
+// HealthyComponents returns the names of the components whose status is ok, up or healthy, in
+// alphabetical order. Comparing it with the keys of Components shows which subsystems are degraded.
+//
+// Returns:
+//   - []string: The names of the healthy components
+func (h *HealthResponse) HealthyComponents() []string {
+	healthy := make([]string, 0, len(h.Components))
+	for name, status := range h.Components {
+		if healthyStatuses[strings.ToLower(status)] {
+			healthy = append(healthy, name)
+		}
+	}
+	sort.Strings(healthy)
+
+	return healthy
+}
+
+// GetHealth retrieves the current health status of the Data Warehouse.
+//
+// Parameters:
+//   - opts: Optional per-call settings such as WithContext
+//
+// Returns:
+//   - *HealthResponse: The reported health status
+//   - error: An *EmptyResponseError if the server returned no body, otherwise an error reporting issues in sending the request, handling the response, or parsing the JSON
+func (c *Client) GetHealth(opts ...RequestOption) (*HealthResponse, error) {
+	endpoint := c.endpoint(OperationGetHealth)
+	body, err := c.get(context.Background(), endpoint, append(opts, operation(OperationGetHealth))...)
+	if err != nil {
+		c.recordHealth(nil, err)
+		return nil, fmt.Errorf("error getting health: %w", err)
+	}
+
+	health, err := parse[HealthResponse](c, endpoint, body)
+	c.recordHealth(health, err)
+	if err != nil {
+		return nil, fmt.Errorf("error getting health: %w", err)
+	}
+
+	return health, nil
+}
@@ -0,0 +1,43 @@
+package client
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestGetHealthEmptyBody(t *testing.T) {
+	for name, body := range map[string]string{"empty": "", "whitespace": " \n\t "} {
+		t.Run(name, func(t *testing.T) {
+			c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				writeJSON(w, http.StatusOK, body)
+			}))
+
+			_, err := c.GetHealth()
+			var empty *EmptyResponseError
+			if !errors.As(err, &empty) {
+				t.Fatalf("GetHealth() error = %v, want *EmptyResponseError", err)
+			}
+			if empty.Endpoint != healthEndpoint {
+				t.Errorf("Endpoint = %q, want %q", empty.Endpoint, healthEndpoint)
+			}
+		})
+	}
+}
+
+func TestGetHealthDecodesBody(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, `{"status":"ok","database":"up","components":{"cache":"UP","search":"down"}}`)
+	}))
+
+	health, err := c.GetHealth()
+	if err != nil {
+		t.Fatalf("GetHealth() error = %v", err)
+	}
+	if health.Status != "ok" || health.Database != "up" {
+		t.Errorf("health = %+v", health)
+	}
+	if got := health.HealthyComponents(); len(got) != 1 || got[0] != "cache" {
+		t.Errorf("HealthyComponents() = %v, want [cache]", got)
+	}
+}
@@ -0,0 +1,152 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrServiceUnhealthy is returned without sending the request when WithHealthGate is set and the
+// Data Warehouse recently reported itself unhealthy.
+var ErrServiceUnhealthy = errors.New("data warehouse is unhealthy")
+
+// healthGate caches the outcome of the latest health check and polls the health endpoint in the background.
+type healthGate struct {
+	interval time.Duration
+	ttl      time.Duration
+
+	mu      sync.Mutex
+	healthy bool
+	checked time.Time
+	history *healthRing
+
+	closeOnce sync.Once
+	stop      context.CancelFunc
+	done      chan struct{}
+}
+
+// WithHealthGate polls GetHealth every interval in the background and makes all other requests fail
+// fast with ErrServiceUnhealthy while the latest health check, at most ttl old, reported the service
+// unhealthy. This avoids pointless requests during known outages. A health check counts as unhealthy
+// when it fails or reports a status other than ok, up or healthy. Health checks made by the caller with
+// GetHealth update the cached state too. Requests are never blocked before the first check completes
+// or once the latest check is older than ttl. Call Close to stop the poller.
+//
+// Parameters:
+//   - interval: Time between background health checks
+//   - ttl: How long an unhealthy result keeps blocking requests
+//
+// Returns:
+//   - Option: Option enabling the health gate
+func WithHealthGate(interval, ttl time.Duration) Option {
+	return func(c *Client) error {
+		if interval <= 0 {
+			return errors.New("health gate interval must be positive")
+		}
+		if ttl <= 0 {
+			return errors.New("health gate ttl must be positive")
+		}
+		c.healthGate = &healthGate{interval: interval, ttl: ttl}
+		return nil
+	}
+}
+
+// Close stops the background work of the client: it waits for the creates queued with
+// CreateArticleAsync to finish, stops their workers and stops the health poller of WithHealthGate.
+// Close is safe to call more than once; the client must not be used for gated requests afterwards
+// since its health state is no longer refreshed.
+//
+// Returns:
+//   - error: Always nil; the error is returned for compatibility with io.Closer
+func (c *Client) Close() error {
+	if c.asyncCreator != nil {
+		c.asyncCreator.close()
+	}
+	if c.healthGate != nil {
+		c.healthGate.close()
+	}
+
+	return nil
+}
+
+// start launches the background health poller. It checks health immediately and then every interval.
+func (g *healthGate) start(c *Client) {
+	ctx, cancel := context.WithCancel(context.Background())
+	g.stop = cancel
+	g.done = make(chan struct{})
+
+	go func() {
+		defer close(g.done)
+
+		ticker := time.NewTicker(g.interval)
+		defer ticker.Stop()
+		for {
+			c.GetHealth(WithContext(ctx))
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// close stops the poller and waits for it to exit.
+func (g *healthGate) close() {
+	g.closeOnce.Do(func() {
+		g.stop()
+		<-g.done
+	})
+}
+
+// record stores the outcome of a health check made at t, adding health to the history if one is kept.
+// A nil health records an unreachable sample.
+func (g *healthGate) record(health *HealthResponse, healthy bool, t time.Time) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.healthy = healthy
+	g.checked = t
+	if g.history == nil {
+		return
+	}
+	if health == nil {
+		g.history.add(HealthResponse{Status: healthUnreachable})
+		return
+	}
+	g.history.add(*health)
+}
+
+// recordHealth updates the health gate, if any, with the outcome of a GetHealth call. Checks aborted
+// because their context was cancelled say nothing about the service and are ignored.
+func (c *Client) recordHealth(health *HealthResponse, err error) {
+	if c.healthGate == nil || errors.Is(err, context.Canceled) {
+		return
+	}
+
+	if err != nil {
+		health = nil
+	}
+	c.healthGate.record(health, health != nil && healthyStatuses[strings.ToLower(health.Status)], c.now())
+}
+
+// checkHealthGate returns ErrServiceUnhealthy if a request for op must not be sent because the latest
+// health check within the ttl reported the service unhealthy. Health checks themselves are never blocked.
+func (c *Client) checkHealthGate(op string) error {
+	if c.healthGate == nil || op == OperationGetHealth {
+		return nil
+	}
+
+	g := c.healthGate
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.checked.IsZero() || g.healthy || c.now().Sub(g.checked) > g.ttl {
+		return nil
+	}
+
+	return ErrServiceUnhealthy
+}
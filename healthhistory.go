@@ -0,0 +1,75 @@
+package client
+
+import (
+	"errors"
+	"maps"
+)
+
+// healthUnreachable is the Status recorded in the health history for checks that got no health response.
+const healthUnreachable = "unreachable"
+
+// healthRing is a fixed-size ring buffer of health samples.
+type healthRing struct {
+	samples []HealthResponse
+	next    int
+	full    bool
+}
+
+// WithHealthHistory keeps the last size health samples of the WithHealthGate poller, and of GetHealth
+// calls made by the caller, in memory for trend analysis, such as a health timeline or the uptime over
+// a window. Checks that got no health response, for example because the request failed, are recorded
+// with Status "unreachable". Memory is bounded by size. Read the samples with HealthHistory.
+// WithHealthHistory requires WithHealthGate.
+//
+// Parameters:
+//   - size: Maximum number of samples kept
+//
+// Returns:
+//   - Option: Option enabling the health history
+func WithHealthHistory(size int) Option {
+	return func(c *Client) error {
+		if size < 1 {
+			return errors.New("health history size must be at least 1")
+		}
+		c.healthHistorySize = size
+		return nil
+	}
+}
+
+// HealthHistory returns the retained health samples, oldest first. It is safe for concurrent use and
+// the returned samples are copies the caller may modify.
+//
+// Returns:
+//   - []HealthResponse: The retained samples, or nil if WithHealthHistory is not set
+func (c *Client) HealthHistory() []HealthResponse {
+	g := c.healthGate
+	if g == nil || g.history == nil {
+		return nil
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	ring := g.history
+	ordered := make([]HealthResponse, 0, len(ring.samples))
+	if ring.full {
+		ordered = append(ordered, ring.samples[ring.next:]...)
+	}
+	ordered = append(ordered, ring.samples[:ring.next]...)
+
+	for i := range ordered {
+		ordered[i].Components = maps.Clone(ordered[i].Components)
+	}
+
+	return ordered
+}
+
+// add stores a copy of sample, overwriting the oldest one once the buffer is full.
+func (r *healthRing) add(sample HealthResponse) {
+	sample.Components = maps.Clone(sample.Components)
+	r.samples[r.next] = sample
+	r.next = (r.next + 1) % len(r.samples)
+	if r.next == 0 {
+		r.full = true
+	}
+}
@@ -0,0 +1,86 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/0ffsideCompass/models"
+)
+
+// ArticleWithRelations is an article together with the relations expanded by the server.
+type ArticleWithRelations struct {
+	Article  *models.Article
+	Included map[string]json.RawMessage
+}
+
+// WithInclude asks the server to expand the given relations of the returned resource, for example
+// WithInclude("tags", "author"), by setting the include query parameter. This saves a round trip per
+// relation on detail pages. Use GetArticleWithRelations to read the expanded data; servers that do not
+// support expansion ignore the parameter.
+//
+// Parameters:
+//   - relations: Names of the relations to expand
+//
+// Returns:
+//   - RequestOption: Option setting the include query parameter
+func WithInclude(relations ...string) RequestOption {
+	return func(o *requestOptions) {
+		if len(relations) == 0 {
+			return
+		}
+		if o.query == nil {
+			o.query = url.Values{}
+		}
+		o.query["include"] = []string{strings.Join(relations, ",")}
+	}
+}
+
+// GetArticleWithRelations retrieves the article with the given ID and expands the given relations in
+// the same request. Relations the server did not include, for example because it does not support
+// them, are simply absent from Included.
+//
+// Parameters:
+//   - id: ID of the article to retrieve
+//   - relations: Names of the relations to expand, such as "tags" or "author"
+//   - opts: Optional per-call settings such as WithContext
+//
+// Returns:
+//   - *ArticleWithRelations: The article and its expanded relations
+//   - error: A *NotFoundError if no article has the ID, an *EmptyEntityError if the response carries no article, otherwise an error reporting issues in sending the request, handling the response, or parsing the JSON
+func (c *Client) GetArticleWithRelations(id string, relations []string, opts ...RequestOption) (*ArticleWithRelations, error) {
+	response, err := c.getArticle(id, append(opts, WithInclude(relations...))...)
+	if err != nil {
+		return nil, err
+	}
+
+	included := response.Included
+	if included == nil {
+		included = map[string]json.RawMessage{}
+	}
+
+	return &ArticleWithRelations{Article: response.Article, Included: included}, nil
+}
+
+// Relation decodes the expanded relation name into target and reports whether it was included.
+//
+// Parameters:
+//   - name: Name of the relation
+//   - target: Non-nil pointer the relation is decoded into
+//
+// Returns:
+//   - bool: True if the server included the relation
+//   - error: An error if the relation could not be decoded into target
+func (a *ArticleWithRelations) Relation(name string, target interface{}) (bool, error) {
+	raw, ok := a.Included[name]
+	if !ok || string(raw) == "null" {
+		return false, nil
+	}
+
+	if err := json.Unmarshal(raw, target); err != nil {
+		return true, fmt.Errorf("error decoding relation %s: %w", name, err)
+	}
+
+	return true, nil
+}
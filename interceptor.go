@@ -0,0 +1,62 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// RequestInterceptor mutates an outgoing request, for example to sign it. Returning an error aborts the
+// request.
+type RequestInterceptor func(req *http.Request) error
+
+// interceptorError wraps an error returned by a RequestInterceptor so the request is not retried.
+type interceptorError struct {
+	err error
+}
+
+// Error implements the error interface.
+func (e *interceptorError) Error() string {
+	return fmt.Sprintf("request interceptor: %v", e.err)
+}
+
+// Unwrap returns the interceptor's error.
+func (e *interceptorError) Unwrap() error {
+	return e.err
+}
+
+// WithRequestInterceptor calls intercept with every request attempt just before it is handed to the
+// HTTP client, for dynamic authentication such as AWS SigV4 signing for a gateway. It runs after all
+// headers are set, including Authorization, User-Agent, per-call headers and Content-Encoding from
+// WithRequestCompression, and after the body is final, so a signature covers exactly what is sent.
+// Middleware and WithLogger see the request before the interceptor changes it; only
+// WithRequestResponseDump, which sits below it, shows the intercepted request. It runs again for every
+// retry, which lets time-based signatures be refreshed. An error aborts the request without retrying.
+// Multiple interceptors run in the order they are registered.
+//
+// Parameters:
+//   - intercept: Function mutating the request
+//
+// Returns:
+//   - Option: Option registering the interceptor
+func WithRequestInterceptor(intercept RequestInterceptor) Option {
+	return func(c *Client) error {
+		if intercept == nil {
+			return errors.New("request interceptor is nil")
+		}
+		c.interceptors = append(c.interceptors, intercept)
+		return nil
+	}
+}
+
+// interceptRoundTrip returns a RoundTrip running the registered interceptors before calling next.
+func (c *Client) interceptRoundTrip(next RoundTrip) RoundTrip {
+	return func(req *http.Request) (*http.Response, error) {
+		for _, intercept := range c.interceptors {
+			if err := intercept(req); err != nil {
+				return nil, &interceptorError{err: err}
+			}
+		}
+		return next(req)
+	}
+}
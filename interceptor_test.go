@@ -0,0 +1,90 @@
+package client
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRequestInterceptorStampsHeader(t *testing.T) {
+	var seenAuth, seenBody string
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("X-Signature"); got != "signed:Bearer test-key" {
+			t.Errorf("X-Signature = %q, want %q", got, "signed:Bearer test-key")
+		}
+		if got := r.Header.Get("X-Order"); got != "first,second" {
+			t.Errorf("X-Order = %q, want interceptors to run in registration order", got)
+		}
+		writeJSON(w, http.StatusCreated, `{}`)
+	}), WithRequestInterceptor(func(req *http.Request) error {
+		seenAuth = req.Header.Get("Authorization")
+		if req.GetBody != nil {
+			body, _ := req.GetBody()
+			data, _ := io.ReadAll(body)
+			seenBody = string(data)
+		}
+		req.Header.Set("X-Signature", "signed:"+seenAuth)
+		req.Header.Set("X-Order", "first")
+		return nil
+	}), WithRequestInterceptor(func(req *http.Request) error {
+		req.Header.Set("X-Order", req.Header.Get("X-Order")+",second")
+		return nil
+	}))
+
+	if err := c.CreateArticle(testArticleRequest()); err != nil {
+		t.Fatalf("CreateArticle() error = %v", err)
+	}
+	if seenAuth != "Bearer test-key" {
+		t.Errorf("interceptor saw Authorization %q, want the standard headers to be set first", seenAuth)
+	}
+	if !strings.Contains(seenBody, `"ext-1"`) {
+		t.Errorf("interceptor saw body %q, want the final request body", seenBody)
+	}
+}
+
+func TestRequestInterceptorRunsForEveryAttempt(t *testing.T) {
+	var requests, intercepted atomic.Int64
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			writeJSON(w, http.StatusServiceUnavailable, `{"error":"busy"}`)
+			return
+		}
+		writeJSON(w, http.StatusOK, `{"status":"ok"}`)
+	}), WithRetry(2, time.Millisecond), WithRequestInterceptor(func(req *http.Request) error {
+		intercepted.Add(1)
+		return nil
+	}))
+
+	if _, err := c.GetHealth(); err != nil {
+		t.Fatalf("GetHealth() error = %v", err)
+	}
+	if got := intercepted.Load(); got != 2 {
+		t.Errorf("interceptor ran %d times, want 2", got)
+	}
+}
+
+func TestRequestInterceptorErrorAborts(t *testing.T) {
+	failure := errors.New("no credentials")
+	var requests, intercepted atomic.Int64
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		writeJSON(w, http.StatusOK, `{"status":"ok"}`)
+	}), WithRetry(3, time.Millisecond), WithRequestInterceptor(func(req *http.Request) error {
+		intercepted.Add(1)
+		return failure
+	}))
+
+	if _, err := c.GetHealth(); !errors.Is(err, failure) {
+		t.Fatalf("GetHealth() error = %v, want %v", err, failure)
+	}
+	if got := requests.Load(); got != 0 {
+		t.Errorf("sent %d requests, want none", got)
+	}
+	if got := intercepted.Load(); got != 1 {
+		t.Errorf("interceptor ran %d times, want the error not to be retried", got)
+	}
+}
@@ -0,0 +1,56 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/0ffsideCompass/models"
+	"golang.org/x/sync/errgroup"
+)
+
+// LatestContent holds the most recently created articles and podcasts.
+type LatestContent struct {
+	Articles []models.Article
+	Podcasts []models.Podcast
+}
+
+// GetLatestContent retrieves the most recently created articles and podcasts concurrently, for pages
+// that show both together. If either request fails the other is cancelled and the first error is returned.
+//
+// Parameters:
+//   - ctx: Context controlling cancellation of both requests
+//   - limit: Maximum number of articles and of podcasts to return
+//
+// Returns:
+//   - *LatestContent: The latest articles and podcasts, newest first
+//   - error: The first error encountered by either request
+func (c *Client) GetLatestContent(ctx context.Context, limit int) (*LatestContent, error) {
+	if err := validatePage(1, limit); err != nil {
+		return nil, err
+	}
+
+	var latest LatestContent
+	group, ctx := errgroup.WithContext(ctx)
+	group.Go(func() error {
+		response, err := c.ListArticlesSorted("created_at", SortDesc, 1, limit, WithContext(ctx))
+		if err != nil {
+			return err
+		}
+		latest.Articles = response.Articles
+		return nil
+	})
+	group.Go(func() error {
+		response, err := c.ListPodcastsSorted("created_at", SortDesc, 1, limit, WithContext(ctx))
+		if err != nil {
+			return err
+		}
+		latest.Podcasts = response.Podcasts
+		return nil
+	})
+
+	if err := group.Wait(); err != nil {
+		return nil, fmt.Errorf("error getting latest content: %w", err)
+	}
+
+	return &latest, nil
+}
@@ -0,0 +1,69 @@
+package client
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"runtime"
+	"sync/atomic"
+)
+
+// bodyLeakChecker reports response bodies that are garbage collected without having been closed.
+type bodyLeakChecker struct {
+	onLeak func(method, endpoint string)
+}
+
+// trackedBody records whether a response body has been closed.
+type trackedBody struct {
+	io.ReadCloser
+	closed atomic.Bool
+}
+
+// WithBodyLeakCheck is a debugging aid that reports every response body that is never closed, for
+// example by custom middleware that replaces a response without closing the original or by a streaming
+// method that forgets to release its connection. Each body returned by the underlying HTTP client is
+// tracked, and onLeak is invoked from a finalizer when the garbage collector finds one that was not
+// closed. Detection therefore depends on garbage collection running, which tests can force with
+// runtime.GC. Leave it disabled in production: tracking adds an allocation and a finalizer per response.
+//
+// Parameters:
+//   - onLeak: Callback receiving the method and endpoint path of each leaked response body
+//
+// Returns:
+//   - Option: Option enabling body leak checking
+func WithBodyLeakCheck(onLeak func(method, endpoint string)) Option {
+	return func(c *Client) error {
+		if onLeak == nil {
+			return errors.New("body leak callback is nil")
+		}
+		c.leakCheck = &bodyLeakChecker{onLeak: onLeak}
+		return nil
+	}
+}
+
+// wrap returns a RoundTrip tracking the response body of each call to next.
+func (l *bodyLeakChecker) wrap(next RoundTrip) RoundTrip {
+	return func(req *http.Request) (*http.Response, error) {
+		res, err := next(req)
+		if err != nil || res.Body == nil {
+			return res, err
+		}
+
+		method, endpoint := req.Method, req.URL.Path
+		body := &trackedBody{ReadCloser: res.Body}
+		runtime.SetFinalizer(body, func(b *trackedBody) {
+			if !b.closed.Load() {
+				l.onLeak(method, endpoint)
+			}
+		})
+		res.Body = body
+
+		return res, nil
+	}
+}
+
+// Close closes the underlying body and marks it as closed.
+func (b *trackedBody) Close() error {
+	b.closed.Store(true)
+	return b.ReadCloser.Close()
+}
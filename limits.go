@@ -0,0 +1,131 @@
+package client
+
+import (
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// ErrResponseTooLarge is returned when a response body, after decompression, exceeds the limit set
+// with WithMaxResponseBytes.
+var ErrResponseTooLarge = errors.New("response body exceeds the maximum size")
+
+// ErrRequestTooLarge is returned without sending the request when a JSON request body exceeds the
+// limit set with WithMaxRequestBytes.
+var ErrRequestTooLarge = errors.New("request body exceeds the maximum size")
+
+// WithMaxRequestBytes rejects JSON request bodies larger than n bytes locally with ErrRequestTooLarge,
+// giving a clearer error than the 413 Content Too Large the server would answer with after the body was
+// transferred. Set it to the server's limit. The size is checked before WithRequestCompression, so the
+// check is conservative for compressed bodies. BatchCreateArticles splits batches to stay within the
+// limit. There is no limit by default.
+//
+// Parameters:
+//   - n: Maximum size of a JSON request body in bytes
+//
+// Returns:
+//   - Option: Option setting the request size limit
+func WithMaxRequestBytes(n int64) Option {
+	return func(c *Client) error {
+		if n < 1 {
+			return errors.New("max request bytes must be at least 1")
+		}
+		c.maxRequestBytes = n
+		return nil
+	}
+}
+
+// WithMaxResponseBytes fails requests whose response body is larger than n bytes with ErrResponseTooLarge,
+// so a malicious or buggy server cannot exhaust memory. The limit applies to the decompressed body:
+// both gzip decoded transparently by net/http and gzip decoded by WithResponseCompression are counted
+// after decompression, so a small compressed payload cannot expand past it.
+//
+// Parameters:
+//   - n: Maximum size of a response body in bytes
+//
+// Returns:
+//   - Option: Option setting the response size limit
+func WithMaxResponseBytes(n int64) Option {
+	return func(c *Client) error {
+		if n < 1 {
+			return errors.New("max response bytes must be at least 1")
+		}
+		c.maxResponseBytes = n
+		return nil
+	}
+}
+
+// WithResponseCompression requests gzip-compressed responses by sending Accept-Encoding: gzip and
+// decompresses them in the client. Unlike the transparent decompression of net/http it also applies
+// when a response is delivered through a custom transport or middleware that does not decompress.
+// Combine it with WithMaxResponseBytes to bound the decompressed size.
+//
+// Returns:
+//   - Option: Option enabling compressed responses
+func WithResponseCompression() Option {
+	return func(c *Client) error {
+		c.responseCompression = true
+		return nil
+	}
+}
+
+// gzipBody closes both the gzip reader and the compressed body it reads from.
+type gzipBody struct {
+	*gzip.Reader
+	body io.ReadCloser
+}
+
+// Close closes the gzip reader and the underlying body.
+func (b *gzipBody) Close() error {
+	b.Reader.Close()
+	return b.body.Close()
+}
+
+// responseBody returns the body of res, decompressed if WithResponseCompression is enabled and the
+// server answered with gzip. The decompressed body is read until the gzip stream ends, never up to the
+// Content-Length, which counts compressed bytes, so bodies sent with a Content-Length and chunked
+// bodies are handled alike and a truncated stream fails with io.ErrUnexpectedEOF instead of being cut
+// short silently. As net/http does for transparent decompression, res is updated to describe the
+// decompressed body: Content-Encoding and Content-Length are removed, ContentLength becomes -1 and
+// Uncompressed is set. An empty body, as sent with 204 No Content, is returned as is.
+func (c *Client) responseBody(res *http.Response) (io.ReadCloser, error) {
+	if !c.responseCompression || !strings.EqualFold(res.Header.Get("Content-Encoding"), "gzip") || res.ContentLength == 0 {
+		return res.Body, nil
+	}
+
+	reader, err := gzip.NewReader(res.Body)
+	if errors.Is(err, io.EOF) {
+		return res.Body, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing response: %w", err)
+	}
+
+	res.Header.Del("Content-Encoding")
+	res.Header.Del("Content-Length")
+	res.ContentLength = -1
+	res.Uncompressed = true
+
+	return &gzipBody{Reader: reader, body: res.Body}, nil
+}
+
+// readAllLimited reads r to completion, failing with ErrResponseTooLarge if it holds more than limit
+// bytes. A limit of zero or less means no limit. The body is read into a pooled buffer.
+func readAllLimited(r io.Reader, limit int64) ([]byte, error) {
+	if limit <= 0 {
+		return readPooled(r)
+	}
+
+	data, err := readPooled(io.LimitReader(r, limit+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > limit {
+		return nil, ErrResponseTooLarge
+	}
+
+	return data, nil
+}
@@ -0,0 +1,124 @@
+package client
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// gzipped returns data compressed with gzip.
+func gzipped(t *testing.T, data []byte) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	if _, err := writer.Write(data); err != nil {
+		t.Fatalf("gzip Write() error = %v", err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("gzip Close() error = %v", err)
+	}
+	return buf.Bytes()
+}
+
+// writeGzip writes body as a gzip-encoded JSON response.
+func writeGzip(w http.ResponseWriter, body []byte) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Encoding", "gzip")
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
+}
+
+func TestResponseCompressionDecodesGzip(t *testing.T) {
+	body := gzipped(t, []byte(`{"status":"ok","database":"up"}`))
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Accept-Encoding"); got != "gzip" {
+			t.Errorf("Accept-Encoding = %q, want gzip", got)
+		}
+		writeGzip(w, body)
+	}), WithResponseCompression())
+
+	health, err := c.GetHealth()
+	if err != nil {
+		t.Fatalf("GetHealth() error = %v", err)
+	}
+	if health.Status != "ok" || health.Database != "up" {
+		t.Errorf("health = %+v", health)
+	}
+}
+
+func TestMaxResponseBytesLimitsDecompressedSize(t *testing.T) {
+	const limit = 4 << 10
+	bomb := gzipped(t, []byte(`{"status":"`+strings.Repeat("a", 1<<20)+`"}`))
+	if len(bomb) >= limit {
+		t.Fatalf("compressed body is %d bytes, want it below the %d byte limit", len(bomb), limit)
+	}
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeGzip(w, bomb)
+	}), WithResponseCompression(), WithMaxResponseBytes(limit))
+
+	if _, err := c.GetHealth(); !errors.Is(err, ErrResponseTooLarge) {
+		t.Fatalf("GetHealth() error = %v, want ErrResponseTooLarge", err)
+	}
+}
+
+func TestMaxResponseBytesAllowsBodyAtLimit(t *testing.T) {
+	body := []byte(`{"status":"ok"}`)
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeGzip(w, gzipped(t, body))
+	}), WithResponseCompression(), WithMaxResponseBytes(int64(len(body))))
+
+	if _, err := c.GetHealth(); err != nil {
+		t.Fatalf("GetHealth() error = %v", err)
+	}
+}
+
+func TestResponseCompressionFraming(t *testing.T) {
+	status := strings.Repeat("ok", 10000)
+	compressed := gzipped(t, []byte(`{"status":"`+status+`"}`))
+	for name, write := range map[string]func(http.ResponseWriter){
+		"content-length": func(w http.ResponseWriter) {
+			w.Header().Set("Content-Length", strconv.Itoa(len(compressed)))
+			writeGzip(w, compressed)
+		},
+		"chunked": func(w http.ResponseWriter) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("Content-Encoding", "gzip")
+			w.WriteHeader(http.StatusOK)
+			for chunk := range slices.Chunk(compressed, 64) {
+				w.Write(chunk)
+				w.(http.Flusher).Flush()
+			}
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { write(w) }),
+				WithResponseCompression(), WithMaxResponseBytes(1<<20))
+
+			health, err := c.GetHealth()
+			if err != nil {
+				t.Fatalf("GetHealth() error = %v", err)
+			}
+			if health.Status != status {
+				t.Errorf("Status has %d bytes, want the full %d decompressed bytes", len(health.Status), len(status))
+			}
+		})
+	}
+}
+
+func TestResponseCompressionEmptyGzipBody(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Encoding", "gzip")
+		w.WriteHeader(http.StatusOK)
+	}), WithResponseCompression())
+
+	var empty *EmptyResponseError
+	if _, err := c.GetHealth(); !errors.As(err, &empty) {
+		t.Fatalf("GetHealth() error = %v, want *EmptyResponseError", err)
+	}
+}
@@ -0,0 +1,43 @@
+package client
+
+import (
+	"fmt"
+
+	"golang.org/x/text/language"
+)
+
+// WithLocale sends an Accept-Language header with every request so the Data Warehouse can serve
+// localized content, such as descriptions, in the given language. The tag is validated and sent in
+// its canonical BCP 47 form, for example "pt-BR". Localization is up to the server: deployments that
+// do not localize ignore the header and return their default language, so the header is a preference,
+// not a guarantee. Use WithLanguage to override the locale for a single call.
+//
+// Parameters:
+//   - lang: BCP 47 language tag, such as "en", "de" or "pt-BR"
+//
+// Returns:
+//   - Option: Option setting the default locale
+func WithLocale(lang string) Option {
+	return func(c *Client) error {
+		tag, err := language.Parse(lang)
+		if err != nil {
+			return fmt.Errorf("invalid locale %q: %w", lang, err)
+		}
+		c.locale = tag.String()
+		return nil
+	}
+}
+
+// WithLanguage sets the Accept-Language header of the call, overriding the locale set with WithLocale.
+// Parse user-supplied tags with language.Parse to validate them.
+//
+// Parameters:
+//   - tag: Language to request the content in
+//
+// Returns:
+//   - RequestOption: Option setting the Accept-Language header
+func WithLanguage(tag language.Tag) RequestOption {
+	return func(o *requestOptions) {
+		o.header.Set("Accept-Language", tag.String())
+	}
+}
@@ -0,0 +1,40 @@
+package client
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// byURLEndpoint returns endpoint with the given resource URL encoded as the url query parameter.
+// The full URL, including its own query string and fragment, is escaped so it survives as a single value.
+//
+// Parameters:
+//   - endpoint: Collection endpoint to query
+//   - resourceURL: URL of the resource to look up
+//
+// Returns:
+//   - string: The endpoint with the encoded query string
+func byURLEndpoint(endpoint, resourceURL string) string {
+	return endpoint + "?" + url.Values{"url": {resourceURL}}.Encode()
+}
+
+// singleMatch returns the only element of items.
+//
+// Parameters:
+//   - items: The matches returned by the server
+//   - resource: Name of the resource, used in error messages
+//   - key: Value that was looked up, used in error messages
+//
+// Returns:
+//   - *T: The single match
+//   - error: A *NotFoundError if items is empty, or an error if there is more than one match
+func singleMatch[T any](items []T, resource, key string) (*T, error) {
+	switch len(items) {
+	case 0:
+		return nil, &NotFoundError{Resource: resource, Key: key}
+	case 1:
+		return &items[0], nil
+	default:
+		return nil, fmt.Errorf("expected a single %s for %s, got %d", resource, key, len(items))
+	}
+}
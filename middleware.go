@@ -0,0 +1,411 @@
+package client
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"time"
+)
+
+const (
+	// maxLoggedBody bounds how much of a request or response body is written to the log.
+	maxLoggedBody = 1024
+
+	// maxBufferedLogBody bounds how much of a body is read for logging when neither WithMaxRequestBytes
+	// nor WithMaxResponseBytes applies. Bodies are redacted whole, so larger bodies are not logged.
+	maxBufferedLogBody = 1 << 20
+)
+
+// RoundTrip sends a single HTTP request attempt and returns its response.
+// It is the primitive the Client's middleware chain is built from.
+type RoundTrip func(*http.Request) (*http.Response, error)
+
+// Middleware wraps a RoundTrip with additional behaviour such as logging, metrics or auth refresh.
+// Implementations must call next to continue the chain, and must not close the response body
+// unless they replace it.
+type Middleware func(next RoundTrip) RoundTrip
+
+// Logger receives log lines from the logging middleware.
+type Logger interface {
+	Logf(format string, args ...interface{})
+}
+
+// LoggerFunc adapts a printf-style function, such as log.Printf, to the Logger interface.
+type LoggerFunc func(format string, args ...interface{})
+
+// Logf implements Logger.
+func (f LoggerFunc) Logf(format string, args ...interface{}) {
+	f(format, args...)
+}
+
+// RequestMetrics describes a completed request attempt reported to a MetricsRecorder.
+// Client holds the name set with WithName and is empty for unnamed clients.
+type RequestMetrics struct {
+	Client     string
+	Method     string
+	Endpoint   string
+	StatusCode int
+	Duration   time.Duration
+	Err        error
+}
+
+// MetricsRecorder receives a RequestMetrics value for every request attempt.
+type MetricsRecorder interface {
+	RecordRequest(metrics RequestMetrics)
+}
+
+// WithMiddleware adds middleware around every request attempt made by the client.
+// Middleware runs in the order it is registered: the first registered middleware is the outermost, so it
+// sees the request first and the response last. Middleware wraps individual attempts, so when WithRetry is
+// enabled it runs once per attempt. WithLogger and WithMetrics register built-in middleware at the
+// position they appear among the options.
+//
+// Parameters:
+//   - middleware: Middleware to add, outermost first
+//
+// Returns:
+//   - Option: Option registering the middleware
+func WithMiddleware(middleware ...Middleware) Option {
+	return func(c *Client) error {
+		for _, mw := range middleware {
+			if mw == nil {
+				return errors.New("middleware is nil")
+			}
+		}
+		c.middleware = append(c.middleware, middleware...)
+		return nil
+	}
+}
+
+// WithLogger logs every request attempt through logger using LoggingMiddleware.
+//
+// Parameters:
+//   - logger: Logger receiving one line per attempt
+//
+// Returns:
+//   - Option: Option registering the logging middleware
+func WithLogger(logger Logger) Option {
+	return func(c *Client) error {
+		if logger == nil {
+			return errors.New("logger is nil")
+		}
+		c.middleware = append(c.middleware, loggingMiddleware(logger, func() []string { return c.redactedLogFields }, c.logBodyLimits))
+		return nil
+	}
+}
+
+// WithRedactedLogFields masks the named top-level JSON fields with "***" in bodies logged by WithLogger.
+// Only the logged copy is changed; the body sent to the Data Warehouse is untouched.
+//
+// Parameters:
+//   - fields: Top-level JSON field names to mask
+//
+// Returns:
+//   - Option: Option setting the redacted fields
+func WithRedactedLogFields(fields ...string) Option {
+	return func(c *Client) error {
+		c.redactedLogFields = append(c.redactedLogFields, fields...)
+		return nil
+	}
+}
+
+// WithMetrics reports every request attempt to recorder using MetricsMiddleware.
+//
+// Parameters:
+//   - recorder: MetricsRecorder receiving one value per attempt
+//
+// Returns:
+//   - Option: Option registering the metrics middleware
+func WithMetrics(recorder MetricsRecorder) Option {
+	if recorder == nil {
+		return func(*Client) error { return errors.New("metrics recorder is nil") }
+	}
+	return WithMiddleware(MetricsMiddleware(recorder))
+}
+
+// LoggingMiddleware returns middleware that logs the method, endpoint, status and duration of each attempt,
+// together with the JSON request and response bodies. Top-level fields listed in redactFields are
+// replaced with "***" in the logged bodies; when redactFields is set, bodies that cannot be parsed as
+// JSON are logged as a placeholder instead. Bodies larger than 1 MiB are not logged.
+//
+// Parameters:
+//   - logger: Logger receiving one line per attempt
+//   - redactFields: Top-level JSON field names to mask in logged bodies
+//
+// Returns:
+//   - Middleware: The logging middleware
+func LoggingMiddleware(logger Logger, redactFields ...string) Middleware {
+	return loggingMiddleware(logger, func() []string { return redactFields }, func() (int64, int64) { return 0, 0 })
+}
+
+// loggingMiddleware implements LoggingMiddleware, reading the redacted fields and body size limits at
+// request time so client options applied after WithLogger still take effect.
+func loggingMiddleware(logger Logger, redactFields func() []string, limits func() (request, response int64)) Middleware {
+	return func(next RoundTrip) RoundTrip {
+		return func(req *http.Request) (*http.Response, error) {
+			requestLimit, responseLimit := limits()
+			reqBody := requestBodyForLog(req, redactFields(), requestLimit)
+
+			start := time.Now()
+			res, err := next(req)
+			duration := time.Since(start)
+			if err != nil {
+				logger.Logf("%s: %s %s error=%v duration=%s request_body=%s", logPrefix(req.Context()), req.Method, req.URL.Path, err, duration, reqBody)
+				return res, err
+			}
+
+			resBody := responseBodyForLog(req.Context(), res, redactFields(), responseLimit)
+			logger.Logf("%s: %s %s status=%d duration=%s%s request_body=%s response_body=%s", logPrefix(req.Context()), req.Method, req.URL.Path, res.StatusCode, duration, compressionForLog(req.Context()), reqBody, resBody)
+			return res, nil
+		}
+	}
+}
+
+// MetricsMiddleware returns middleware that reports each attempt to recorder.
+//
+// Parameters:
+//   - recorder: MetricsRecorder receiving one value per attempt
+//
+// Returns:
+//   - Middleware: The metrics middleware
+func MetricsMiddleware(recorder MetricsRecorder) Middleware {
+	return func(next RoundTrip) RoundTrip {
+		return func(req *http.Request) (*http.Response, error) {
+			start := time.Now()
+			res, err := next(req)
+
+			metrics := RequestMetrics{
+				Client:   clientNameFromContext(req.Context()),
+				Method:   req.Method,
+				Endpoint: req.URL.Path,
+				Duration: time.Since(start),
+				Err:      err,
+			}
+			if res != nil {
+				metrics.StatusCode = res.StatusCode
+			}
+			recorder.RecordRequest(metrics)
+
+			return res, err
+		}
+	}
+}
+
+// buildRoundTrip composes the registered middleware around the underlying HTTP client.
+// The slow request watcher sits innermost, directly around the HTTP client, so it times only the HTTP
+// exchange and not the dump, interceptors or tracing above it. Debugging dumps, when enabled, sit
+// directly above it and below the request interceptors, so they capture exactly what goes over the
+// wire. The body leak check wraps the bodies returned below it, so bodies replaced
+// by middleware are still tracked.
+func (c *Client) buildRoundTrip() RoundTrip {
+	roundTrip := RoundTrip(c.client.Do)
+	if c.slowRequests != nil {
+		roundTrip = c.slowRequests.wrap(roundTrip)
+	}
+	if c.dump != nil {
+		roundTrip = c.dump.wrap(roundTrip)
+	}
+	if len(c.interceptors) > 0 {
+		roundTrip = c.interceptRoundTrip(roundTrip)
+	}
+	if c.leakCheck != nil {
+		roundTrip = c.leakCheck.wrap(roundTrip)
+	}
+	if c.tracer != nil {
+		roundTrip = c.tracer.wrap(roundTrip)
+	}
+	for i := len(c.middleware) - 1; i >= 0; i-- {
+		roundTrip = c.middleware[i](roundTrip)
+	}
+
+	return roundTrip
+}
+
+// logBodyLimits returns the request and response size limits bounding how much of a body is read for
+// logging.
+func (c *Client) logBodyLimits() (request, response int64) {
+	return c.maxRequestBytes, c.maxResponseBytes
+}
+
+// logReadLimit returns the number of body bytes read for logging under limit, the client's size limit
+// or zero if there is none.
+func logReadLimit(limit int64) int64 {
+	if limit <= 0 {
+		return maxBufferedLogBody
+	}
+
+	return limit
+}
+
+// requestBodyForLog returns the redacted request body for logging without consuming it.
+// Streaming bodies that cannot be replayed are not logged. The whole body, up to limit bytes, is read
+// so redaction sees complete JSON; larger bodies are logged as a placeholder.
+func requestBodyForLog(req *http.Request, redactFields []string, limit int64) string {
+	if req.GetBody == nil {
+		return ""
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return ""
+	}
+	defer body.Close()
+
+	var reader io.Reader = body
+	if req.Header.Get("Content-Encoding") == "gzip" {
+		gz, err := gzip.NewReader(body)
+		if err != nil {
+			return ""
+		}
+		reader = gz
+	}
+
+	limit = logReadLimit(limit)
+	data, err := io.ReadAll(io.LimitReader(reader, limit+1))
+	if err != nil {
+		return ""
+	}
+	if int64(len(data)) > limit {
+		return tooLargeForLog(limit)
+	}
+
+	return formatBodyForLog(data, redactFields)
+}
+
+// responseBodyForLog returns the redacted response body for logging and restores res.Body so the
+// caller can still read it. Non-JSON bodies are not logged. At most limit bytes are buffered, so
+// WithMaxResponseBytes still bounds memory; larger bodies are logged as a placeholder and the unread
+// rest stays in res.Body behind the buffered bytes. If ctx is done first, the body is closed and
+// reading the restored body fails with ctx.Err().
+func responseBodyForLog(ctx context.Context, res *http.Response, redactFields []string, limit int64) string {
+	mediaType, _, _ := mime.ParseMediaType(res.Header.Get("Content-Type"))
+	if mediaType != "application/json" && mediaType != "application/problem+json" {
+		return ""
+	}
+
+	limit = logReadLimit(limit)
+	data, err := readForLog(ctx, res.Body, limit+1)
+	if ctxErr := ctx.Err(); ctxErr != nil && errors.Is(err, ctxErr) {
+		res.Body = io.NopCloser(&errorReader{err: ctxErr})
+		return ""
+	}
+	if err != nil {
+		res.Body.Close()
+		res.Body = io.NopCloser(io.MultiReader(bytes.NewReader(data), &errorReader{err: err}))
+		return ""
+	}
+	if int64(len(data)) > limit {
+		res.Body = &prefixedBody{Reader: io.MultiReader(bytes.NewReader(data), res.Body), body: res.Body}
+		return tooLargeForLog(limit)
+	}
+
+	res.Body.Close()
+	res.Body = io.NopCloser(bytes.NewReader(data))
+	return formatBodyForLog(data, redactFields)
+}
+
+// readForLog reads up to n bytes of body unless ctx is done first, in which case body is closed to
+// unblock the read and ctx.Err() is returned.
+func readForLog(ctx context.Context, body io.ReadCloser, n int64) ([]byte, error) {
+	type result struct {
+		data []byte
+		err  error
+	}
+
+	done := make(chan result, 1)
+	go func() {
+		data, err := io.ReadAll(io.LimitReader(body, n))
+		done <- result{data: data, err: err}
+	}()
+
+	select {
+	case r := <-done:
+		return r.data, r.err
+	case <-ctx.Done():
+		body.Close()
+		return nil, ctx.Err()
+	}
+}
+
+// prefixedBody is a response body whose first bytes were already read for logging.
+type prefixedBody struct {
+	io.Reader
+	body io.ReadCloser
+}
+
+// Close closes the underlying body.
+func (b *prefixedBody) Close() error {
+	return b.body.Close()
+}
+
+// errorReader is a reader failing with err.
+type errorReader struct {
+	err error
+}
+
+// Read implements io.Reader.
+func (r *errorReader) Read([]byte) (int, error) {
+	return 0, r.err
+}
+
+// tooLargeForLog returns the placeholder logged for bodies larger than limit.
+func tooLargeForLog(limit int64) string {
+	return fmt.Sprintf("<body not logged, more than %d bytes>", limit)
+}
+
+// formatBodyForLog masks redactFields in a JSON object body and truncates the result. Redaction runs
+// on the complete body before truncation; when fields are to be redacted but the body is not a JSON
+// object, a placeholder is returned so nothing is logged in clear text.
+func formatBodyForLog(data []byte, redactFields []string) string {
+	if len(redactFields) > 0 {
+		redacted, ok := redactJSON(data, redactFields)
+		if !ok {
+			return fmt.Sprintf("<unparseable body, %d bytes>", len(data))
+		}
+		data = redacted
+	}
+
+	return truncate(string(data), maxLoggedBody)
+}
+
+// redactJSON replaces the named top-level fields of a JSON object with "***". It reports false for
+// bodies that are not JSON objects.
+func redactJSON(data []byte, fields []string) ([]byte, bool) {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return data, true
+	}
+
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, false
+	}
+
+	masked := json.RawMessage(`"***"`)
+	for _, field := range fields {
+		if _, ok := object[field]; ok {
+			object[field] = masked
+		}
+	}
+
+	redacted, err := json.Marshal(object)
+	if err != nil {
+		return nil, false
+	}
+
+	return redacted, true
+}
+
+// logPrefix returns the prefix of log lines, including the client name when one is set.
+func logPrefix(ctx context.Context) string {
+	if name := clientNameFromContext(ctx); name != "" {
+		return fmt.Sprintf("data warehouse request (%s)", name)
+	}
+
+	return "data warehouse request"
+}
@@ -0,0 +1,92 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/0ffsideCompass/models"
+)
+
+// logRecorder is a Logger collecting log lines.
+type logRecorder struct {
+	mu    sync.Mutex
+	lines []string
+}
+
+func (r *logRecorder) Logf(format string, args ...interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.lines = append(r.lines, fmt.Sprintf(format, args...))
+}
+
+func (r *logRecorder) String() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return strings.Join(r.lines, "\n")
+}
+
+func TestLoggerRedactsLargeBodies(t *testing.T) {
+	long := strings.Repeat("x", 2*maxLoggedBody)
+	logs := &logRecorder{}
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.Copy(io.Discard, r.Body)
+		writeJSON(w, http.StatusOK, fmt.Sprintf(`{"external_id":"response-secret","title":%q}`, long))
+	}), WithLogger(logs), WithRedactedLogFields("external_id"))
+
+	err := c.CreateArticle(models.DataWarehouseCreateArticleRequest{ExternalID: "request-secret", Title: long})
+	if err != nil {
+		t.Fatalf("CreateArticle() error = %v", err)
+	}
+
+	out := logs.String()
+	for _, secret := range []string{"request-secret", "response-secret"} {
+		if strings.Contains(out, secret) {
+			t.Errorf("log contains redacted value %q: %s", secret, out)
+		}
+	}
+	if !strings.Contains(out, `"external_id":"***"`) {
+		t.Errorf("log does not contain the masked field: %s", out)
+	}
+}
+
+func TestLoggerUnparseableBodyPlaceholder(t *testing.T) {
+	got := formatBodyForLog([]byte(`{"url":"secret"`), []string{"url"})
+	if want := "<unparseable body, 15 bytes>"; got != want {
+		t.Errorf("formatBodyForLog() = %q, want %q", got, want)
+	}
+}
+
+func TestLoggerHonorsMaxResponseBytes(t *testing.T) {
+	logs := &logRecorder{}
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, fmt.Sprintf(`{"article":{"id":"1","title":%q}}`, strings.Repeat("x", 4096)))
+	}), WithLogger(logs), WithMaxResponseBytes(512))
+
+	_, err := c.GetArticle("1")
+	if !errors.Is(err, ErrResponseTooLarge) {
+		t.Fatalf("GetArticle() error = %v, want ErrResponseTooLarge", err)
+	}
+	if out := logs.String(); !strings.Contains(out, "<body not logged, more than 512 bytes>") {
+		t.Errorf("log does not contain the size placeholder: %s", out)
+	}
+}
+
+func TestLoggerRestoresResponseBody(t *testing.T) {
+	logs := &logRecorder{}
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, `{"article":{"id":"1","title":"Match report"}}`)
+	}), WithLogger(logs))
+
+	article, err := c.GetArticle("1")
+	if err != nil {
+		t.Fatalf("GetArticle() error = %v", err)
+	}
+	if article.Title != "Match report" {
+		t.Errorf("Title = %q, want %q", article.Title, "Match report")
+	}
+}
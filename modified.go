@@ -0,0 +1,30 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// validateSince checks the since argument of the incremental sync methods against the client's clock.
+func (c *Client) validateSince(since time.Time) error {
+	if since.IsZero() {
+		return errors.New("since is zero")
+	}
+	if now := c.now(); since.After(now) {
+		return fmt.Errorf("since %s is in the future (now is %s)", formatSince(since), formatSince(now))
+	}
+
+	return nil
+}
+
+// formatSince formats t as an RFC 3339 timestamp in UTC, the format expected by the updated_since filter.
+func formatSince(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+// modifiedSinceQuery returns endpoint with an updated_since query parameter appended.
+func modifiedSinceQuery(endpoint string, since time.Time) string {
+	return appendQuery(endpoint, url.Values{"updated_since": {formatSince(since)}})
+}
@@ -0,0 +1,107 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// MultiClient groups several Clients, typically one per regional Data Warehouse instance,
+// so fleet-wide operations can be issued in one call.
+type MultiClient struct {
+	clients []*Client
+}
+
+// HealthCheckError reports the instances whose health could not be retrieved by CheckAllHealth.
+type HealthCheckError struct {
+	Errors map[string]error
+}
+
+// Error implements the error interface.
+func (e *HealthCheckError) Error() string {
+	urls := make([]string, 0, len(e.Errors))
+	for url := range e.Errors {
+		urls = append(urls, url)
+	}
+	sort.Strings(urls)
+
+	messages := make([]string, 0, len(urls))
+	for _, url := range urls {
+		messages = append(messages, fmt.Sprintf("%s: %v", url, e.Errors[url]))
+	}
+
+	return fmt.Sprintf("health check failed for %d instance(s): %s", len(urls), strings.Join(messages, "; "))
+}
+
+// NewMultiClient returns a MultiClient wrapping the given clients.
+//
+// Parameters:
+//   - clients: Clients to group; each must be non-nil and have a distinct base URL
+//
+// Returns:
+//   - *MultiClient: The new MultiClient
+//   - error: Error if no clients are given, a client is nil, or two clients share a base URL
+func NewMultiClient(clients ...*Client) (*MultiClient, error) {
+	if len(clients) == 0 {
+		return nil, errors.New("no clients provided")
+	}
+
+	seen := make(map[string]bool, len(clients))
+	for _, c := range clients {
+		if c == nil {
+			return nil, errors.New("client is nil")
+		}
+		if seen[c.url] {
+			return nil, fmt.Errorf("duplicate client for %s", c.url)
+		}
+		seen[c.url] = true
+	}
+
+	return &MultiClient{clients: append([]*Client(nil), clients...)}, nil
+}
+
+// CheckAllHealth queries the health of every instance concurrently.
+// A failing instance does not fail the whole call: the health of every reachable instance is returned,
+// and the failures are reported together in a *HealthCheckError keyed by base URL.
+//
+// Parameters:
+//   - ctx: Context controlling cancellation of the health checks
+//
+// Returns:
+//   - map[string]*HealthResponse: Health of each reachable instance keyed by base URL
+//   - error: A *HealthCheckError if any instance failed, nil otherwise
+func (m *MultiClient) CheckAllHealth(ctx context.Context) (map[string]*HealthResponse, error) {
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		results = make(map[string]*HealthResponse, len(m.clients))
+		failed  = make(map[string]error)
+	)
+
+	for _, c := range m.clients {
+		wg.Add(1)
+		go func(c *Client) {
+			defer wg.Done()
+
+			health, err := c.GetHealth(WithContext(ctx))
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				failed[c.url] = err
+				return
+			}
+			results[c.url] = health
+		}(c)
+	}
+	wg.Wait()
+
+	if len(failed) > 0 {
+		return results, &HealthCheckError{Errors: failed}
+	}
+
+	return results, nil
+}
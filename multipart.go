@@ -0,0 +1,107 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+)
+
+const (
+	articleAttachmentEndpoint = "/api/v1/articles/{id}/attachments"
+
+	// multipartFileField is the form field name files are sent under.
+	multipartFileField = "file"
+)
+
+// UploadArticleAttachment uploads a file and attaches it to the article with the given ID.
+// The file is streamed to the Data Warehouse as it is read from r, so it is never buffered in memory
+// in full. Because the body is a stream, the upload is never retried. Pass WithContext to bound or cancel
+// the upload; cancellation stops reading from r.
+//
+// Parameters:
+//   - id: ID of the article to attach the file to
+//   - filename: Name of the file as reported to the server
+//   - r: Reader supplying the file contents
+//   - opts: Optional per-call settings such as WithContext
+//
+// Returns:
+//   - error: An error object that reports issues either in sending the request or handling the response
+func (c *Client) UploadArticleAttachment(id, filename string, r io.Reader, opts ...RequestOption) error {
+	if id == "" {
+		return errEmptyID
+	}
+	if filename == "" {
+		return errors.New("filename is empty")
+	}
+	if r == nil {
+		return errors.New("reader is nil")
+	}
+
+	endpoint := withID(c.endpoint(OperationUploadArticleAttachment), id)
+	_, err := c.postMultipart(context.Background(), endpoint, nil, map[string]io.Reader{filename: r}, append(opts, acceptStatus(http.StatusCreated), operation(OperationUploadArticleAttachment))...)
+	if err != nil {
+		return fmt.Errorf("error uploading article attachment: %w", err)
+	}
+
+	return nil
+}
+
+// postMultipart sends a multipart/form-data POST request to the specified endpoint.
+// The body is produced by a goroutine writing into a pipe while the request is being sent, so file
+// contents are streamed rather than buffered. Each file is sent under the "file" form field using its map
+// key as the filename.
+//
+// Parameters:
+//   - ctx: Context controlling cancellation of the request
+//   - endpoint: API endpoint to send the POST request to
+//   - fields: Plain form fields to include
+//   - files: Files to include, keyed by filename
+//   - opts: Optional per-call settings
+//
+// Returns:
+//   - []byte: Response body as a byte slice
+//   - error: Error encountered during the request or response handling
+func (c *Client) postMultipart(ctx context.Context, endpoint string, fields map[string]string, files map[string]io.Reader, opts ...RequestOption) ([]byte, error) {
+	pr, pw := io.Pipe()
+	writer := multipart.NewWriter(pw)
+
+	options := newRequestOptions(opts)
+	req, err := c.newRequest(ctx, http.MethodPost, endpoint, pr, options)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	go func() {
+		pw.CloseWithError(writeMultipart(writer, fields, files))
+	}()
+
+	body, err := c.execute(req, options)
+	pr.Close()
+
+	return body, err
+}
+
+// writeMultipart writes the form fields and files to writer and closes it.
+func writeMultipart(writer *multipart.Writer, fields map[string]string, files map[string]io.Reader) error {
+	for name, value := range fields {
+		if err := writer.WriteField(name, value); err != nil {
+			return err
+		}
+	}
+
+	for filename, r := range files {
+		part, err := writer.CreateFormFile(multipartFileField, filename)
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(part, r); err != nil {
+			return err
+		}
+	}
+
+	return writer.Close()
+}
@@ -0,0 +1,58 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+const (
+	// userAgent is sent with every request; WithName appends the client name to it.
+	userAgent = "data-warehouse-go-client"
+)
+
+// clientNameKey is the context key holding the name of the client that issued a request.
+type clientNameKey struct{}
+
+// WithName labels the client so several clients, such as a primary and a replica, can be told apart.
+// The name is added as a field to log lines, as the Client label of RequestMetrics, and as a suffix of
+// the User-Agent header. Unnamed clients omit the label.
+//
+// Parameters:
+//   - name: Label identifying the client
+//
+// Returns:
+//   - Option: Option setting the client name
+func WithName(name string) Option {
+	return func(c *Client) error {
+		if name == "" {
+			return errors.New("name is empty")
+		}
+		c.name = name
+		return nil
+	}
+}
+
+// userAgentHeader returns the User-Agent header value for the client.
+func (c *Client) userAgentHeader() string {
+	if c.name == "" {
+		return userAgent
+	}
+
+	return fmt.Sprintf("%s (%s)", userAgent, c.name)
+}
+
+// withClientName returns a copy of ctx recording the client name when one is set.
+func (c *Client) withClientName(ctx context.Context) context.Context {
+	if c.name == "" {
+		return ctx
+	}
+
+	return context.WithValue(ctx, clientNameKey{}, c.name)
+}
+
+// clientNameFromContext returns the client name recorded in ctx, or "" for unnamed clients.
+func clientNameFromContext(ctx context.Context) string {
+	name, _ := ctx.Value(clientNameKey{}).(string)
+	return name
+}
@@ -0,0 +1,70 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/0ffsideCompass/models"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// streamCreateConcurrency is the number of creates StreamCreateArticles keeps in flight.
+	streamCreateConcurrency = 4
+)
+
+// StreamCreateArticles reads newline-delimited JSON article requests from r and creates them while
+// reading, with a bounded number of creates in flight, so that multi-gigabyte ingests never have to be
+// held in memory. Only the requests being created are buffered. Processing stops at the first invalid
+// line or failed create, cancelling the creates in flight; articles created before that remain created.
+//
+// Parameters:
+//   - ctx: Context controlling cancellation of the ingest
+//   - r: Reader supplying one JSON article request per line
+//   - onProgress: Optional callback receiving the total number of articles created so far after each create, never concurrently; may be nil
+//
+// Returns:
+//   - error: The first error encountered while decoding a line or creating an article
+func (c *Client) StreamCreateArticles(ctx context.Context, r io.Reader, onProgress func(created int)) error {
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.SetLimit(streamCreateConcurrency)
+
+	var (
+		mu      sync.Mutex
+		created int
+	)
+	decoder := json.NewDecoder(r)
+	for line := 1; groupCtx.Err() == nil; line++ {
+		var request models.DataWarehouseCreateArticleRequest
+		if err := decoder.Decode(&request); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			group.Wait()
+			return fmt.Errorf("error decoding article request %d: %w", line, err)
+		}
+
+		group.Go(func() error {
+			if err := c.CreateArticle(request, WithContext(groupCtx)); err != nil {
+				return fmt.Errorf("error streaming article request %d: %w", line, err)
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			created++
+			if onProgress != nil {
+				onProgress(created)
+			}
+			return nil
+		})
+	}
+
+	if err := group.Wait(); err != nil {
+		return err
+	}
+
+	return ctx.Err()
+}
@@ -0,0 +1,231 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Option configures a Client when it is created with New.
+// Options are applied in order and may return an error to reject an invalid configuration.
+type Option func(*Client) error
+
+// WithDefaultTags sets tags that are merged into the Tags of every article and podcast request.
+// Tags already present on the request are not duplicated and the caller's slice is never modified.
+// This keeps tagging policy, such as a source tag like "crawler-v2", in one place.
+//
+// Parameters:
+//   - tags: Tags to add to every request
+//
+// Returns:
+//   - Option: Option setting the default tags
+func WithDefaultTags(tags ...string) Option {
+	return func(c *Client) error {
+		c.defaultTags = append([]string(nil), tags...)
+		return nil
+	}
+}
+
+// WithTagNormalizer rewrites every tag of article and podcast requests, including default tags, before
+// they are sent, so variants such as "Sports" and " sports" are stored as one tag. Tags that become
+// duplicates after normalization are kept once and tags normalized to an empty string are dropped.
+// The caller's slice is never modified. Pass NormalizeTag for lowercasing and trimming.
+//
+// Parameters:
+//   - normalize: Function returning the normalized form of a tag
+//
+// Returns:
+//   - Option: Option setting the tag normalizer
+func WithTagNormalizer(normalize func(string) string) Option {
+	return func(c *Client) error {
+		if normalize == nil {
+			return errors.New("tag normalizer is nil")
+		}
+		c.tagNormalizer = normalize
+		return nil
+	}
+}
+
+// WithGetContentType controls whether GET and HEAD requests, which have no body, carry the
+// Content-Type: application/json header. It is sent by default; pass false for strict gateways that
+// reject bodiless requests with a Content-Type, typically with 415 Unsupported Media Type. Requests
+// with a body always send it.
+//
+// Parameters:
+//   - send: False to omit Content-Type on GET and HEAD requests
+//
+// Returns:
+//   - Option: Option setting whether GET requests carry a Content-Type
+func WithGetContentType(send bool) Option {
+	return func(c *Client) error {
+		c.omitGetContentType = !send
+		return nil
+	}
+}
+
+// sendsContentType reports whether requests with the given method carry a Content-Type header.
+func (c *Client) sendsContentType(method string) bool {
+	if method == http.MethodGet || method == http.MethodHead {
+		return !c.omitGetContentType
+	}
+
+	return true
+}
+
+// WithJSONMarshaler replaces encoding/json's Marshal for request bodies.
+// This allows a custom encoder, for example one that formats timestamps in a specific layout,
+// or a faster drop-in replacement such as jsoniter for high-throughput use.
+//
+// Parameters:
+//   - marshal: Function used to encode request bodies
+//
+// Returns:
+//   - Option: Option setting the JSON marshaler
+func WithJSONMarshaler(marshal func(interface{}) ([]byte, error)) Option {
+	return func(c *Client) error {
+		if marshal == nil {
+			return errors.New("json marshaler is nil")
+		}
+		c.marshal = marshal
+		return nil
+	}
+}
+
+// WithAcceptStatus extends the set of HTTP status codes treated as success, in addition to 200 OK.
+// This is useful for endpoints that legitimately answer 201 Created or 202 Accepted. Create methods
+// always accept 200 and 201.
+//
+// Parameters:
+//   - codes: Additional status codes to treat as success
+//
+// Returns:
+//   - Option: Option extending the accepted status codes
+func WithAcceptStatus(codes ...int) Option {
+	return func(c *Client) error {
+		if c.acceptStatus == nil {
+			c.acceptStatus = make(map[int]bool, len(codes))
+		}
+		for _, code := range codes {
+			if code < 100 || code > 599 {
+				return fmt.Errorf("invalid status code %d", code)
+			}
+			c.acceptStatus[code] = true
+		}
+		return nil
+	}
+}
+
+// RequestOption configures a single call made by the Client.
+// Request options are passed as trailing arguments to the public methods and only affect that call.
+type RequestOption func(*requestOptions)
+
+// requestOptions holds the per-call settings collected from RequestOption values.
+type requestOptions struct {
+	ctx          context.Context
+	header       http.Header
+	acceptStatus []int
+	idempotent   bool
+	operation    string
+	query        url.Values
+	bodyUnused   bool
+	bodyOf       string
+
+	readAfterWrite readAfterWrite
+}
+
+// newRequestOptions applies the given options to a fresh requestOptions value.
+func newRequestOptions(opts []RequestOption) *requestOptions {
+	options := &requestOptions{header: http.Header{}}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	return options
+}
+
+// WithContext sets the context used for the call.
+// This lets methods that do not take a context parameter be cancelled or bound by a deadline.
+//
+// Parameters:
+//   - ctx: Context controlling cancellation of the request
+//
+// Returns:
+//   - RequestOption: Option setting the request context
+func WithContext(ctx context.Context) RequestOption {
+	return func(o *requestOptions) {
+		o.ctx = ctx
+	}
+}
+
+// WithIfMatch makes the call conditional on the resource still having the given ETag.
+// The value is sent as the If-Match header; if the resource has changed the Data Warehouse responds
+// with 412 Precondition Failed and the call returns a *ConflictError.
+//
+// Parameters:
+//   - etag: The ETag of the version the caller last read
+//
+// Returns:
+//   - RequestOption: Option setting the If-Match header
+func WithIfMatch(etag string) RequestOption {
+	return func(o *requestOptions) {
+		o.header.Set("If-Match", etag)
+	}
+}
+
+// WithIfUnmodifiedSince makes the call conditional on the resource not having changed since t.
+// The value is sent as the If-Unmodified-Since header in HTTP date format; if the resource has changed
+// the Data Warehouse responds with 412 Precondition Failed and the call returns a *ConflictError.
+//
+// Parameters:
+//   - t: The UpdatedAt timestamp of the version the caller last read
+//
+// Returns:
+//   - RequestOption: Option setting the If-Unmodified-Since header
+func WithIfUnmodifiedSince(t time.Time) RequestOption {
+	return func(o *requestOptions) {
+		o.header.Set("If-Unmodified-Since", t.UTC().Format(http.TimeFormat))
+	}
+}
+
+// acceptStatus makes the call additionally treat the given status codes as success.
+// It is used internally by methods whose endpoints document non-200 success codes.
+func acceptStatus(codes ...int) RequestOption {
+	return func(o *requestOptions) {
+		o.acceptStatus = append(o.acceptStatus, codes...)
+	}
+}
+
+// operation records the logical operation, one of the Operation constants, that the call performs.
+// It is used internally so per-operation settings such as WithEndpointTimeout can be applied.
+func operation(op string) RequestOption {
+	return func(o *requestOptions) {
+		o.operation = op
+	}
+}
+
+// preconditionHeaders are the headers that make a write conditional; they are not forwarded to the
+// lookups a write performs.
+var preconditionHeaders = []string{"If-Match", "If-Unmodified-Since"}
+
+// lookupOptions returns request options carrying only the context and the non-precondition headers
+// set by opts, for lookups a method performs on behalf of a write. Options that change how a call is
+// retried or what it returns, such as WithReadAfterWriteRetry or WithFields, only apply to the write.
+func lookupOptions(opts []RequestOption) []RequestOption {
+	options := newRequestOptions(opts)
+	header := options.header.Clone()
+	for _, name := range preconditionHeaders {
+		header.Del(name)
+	}
+
+	return []RequestOption{func(o *requestOptions) {
+		if options.ctx != nil {
+			o.ctx = options.ctx
+		}
+		for name, values := range header {
+			o.header[name] = append([]string(nil), values...)
+		}
+	}}
+}
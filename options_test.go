@@ -0,0 +1,71 @@
+package client
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// updateMethods calls UpdateArticle and UpdatePodcast with the given per-call options.
+var updateMethods = map[string]func(c *Client, opts ...RequestOption) error{
+	"UpdateArticle": func(c *Client, opts ...RequestOption) error {
+		return c.UpdateArticle("a1", testArticleRequest(), opts...)
+	},
+	"UpdatePodcast": func(c *Client, opts ...RequestOption) error {
+		return c.UpdatePodcast("p1", testPodcastRequest(), opts...)
+	},
+}
+
+func TestConditionalUpdateHeaders(t *testing.T) {
+	since := time.Date(2024, 3, 9, 14, 30, 15, 0, time.FixedZone("CET", 3600))
+	for name, update := range updateMethods {
+		for option, test := range map[string]struct {
+			opt           RequestOption
+			header, value string
+		}{
+			"WithIfMatch":           {opt: WithIfMatch(`"v7"`), header: "If-Match", value: `"v7"`},
+			"WithIfUnmodifiedSince": {opt: WithIfUnmodifiedSince(since), header: "If-Unmodified-Since", value: "Sat, 09 Mar 2024 13:30:15 GMT"},
+		} {
+			t.Run(name+"/"+option, func(t *testing.T) {
+				c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					if r.Method != http.MethodPut {
+						t.Errorf("method = %s, want PUT", r.Method)
+					}
+					if got := r.Header.Get(test.header); got != test.value {
+						t.Errorf("%s = %q, want %q", test.header, got, test.value)
+					}
+					writeJSON(w, http.StatusOK, `{}`)
+				}))
+
+				if err := update(c, test.opt); err != nil {
+					t.Fatalf("%s() error = %v", name, err)
+				}
+			})
+		}
+	}
+}
+
+func TestConditionalUpdatePreconditionFailed(t *testing.T) {
+	for name, update := range updateMethods {
+		for option, opt := range map[string]RequestOption{
+			"WithIfMatch":           WithIfMatch(`"v6"`),
+			"WithIfUnmodifiedSince": WithIfUnmodifiedSince(time.Date(2024, 3, 9, 0, 0, 0, 0, time.UTC)),
+		} {
+			t.Run(name+"/"+option, func(t *testing.T) {
+				c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					writeJSON(w, http.StatusPreconditionFailed, `{"error":"resource was modified"}`)
+				}))
+
+				err := update(c, opt)
+				var conflict *ConflictError
+				if !errors.As(err, &conflict) {
+					t.Fatalf("%s() error = %v, want *ConflictError", name, err)
+				}
+				if conflict.StatusCode != http.StatusPreconditionFailed {
+					t.Errorf("StatusCode = %d, want %d", conflict.StatusCode, http.StatusPreconditionFailed)
+				}
+			})
+		}
+	}
+}
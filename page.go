@@ -0,0 +1,116 @@
+package client
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrNoPage is returned by ArticlesPage.Next and ArticlesPage.Prev when there is no neighboring page.
+var ErrNoPage = errors.New("no such page")
+
+// ArticlesPage is one page of articles that can fetch its neighboring pages, so callers such as UI
+// pagination do not have to track page numbers. It embeds the raw list response.
+type ArticlesPage struct {
+	*PaginatedArticlesResponse
+
+	client *Client
+	page   int
+	limit  int
+	opts   []RequestOption
+}
+
+// GetArticlesPage retrieves one page of articles like ListArticles and returns it as an ArticlesPage
+// whose Next and Prev methods fetch the neighboring pages with the same limit and options.
+//
+// Parameters:
+//   - ctx: Context controlling cancellation of the request
+//   - page: 1-based page number
+//   - limit: Maximum number of articles per page
+//   - opts: Optional per-call settings, reused when fetching neighboring pages
+//
+// Returns:
+//   - *ArticlesPage: The page of articles
+//   - error: An error object that reports issues either in sending the request, handling the response, or parsing the JSON
+func (c *Client) GetArticlesPage(ctx context.Context, page, limit int, opts ...RequestOption) (*ArticlesPage, error) {
+	response, err := c.ListArticles(page, limit, append(opts, WithContext(ctx))...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ArticlesPage{
+		PaginatedArticlesResponse: response,
+		client:                    c,
+		page:                      page,
+		limit:                     limit,
+		opts:                      opts,
+	}, nil
+}
+
+// Number returns the 1-based number of the page, as reported by the server or, if it omits it, as requested.
+//
+// Returns:
+//   - int: The page number
+func (p *ArticlesPage) Number() int {
+	if p.Page > 0 {
+		return p.Page
+	}
+
+	return p.page
+}
+
+// pageSize returns the limit of the page, as reported by the server or, if it omits it, as requested.
+func (p *ArticlesPage) pageSize() int {
+	if p.Limit > 0 {
+		return p.Limit
+	}
+
+	return p.limit
+}
+
+// HasNext reports whether articles exist beyond this page according to Total.
+//
+// Returns:
+//   - bool: True if there is a next page
+func (p *ArticlesPage) HasNext() bool {
+	return p.Number()*p.pageSize() < p.Total
+}
+
+// HasPrev reports whether this page is preceded by another page.
+//
+// Returns:
+//   - bool: True if there is a previous page
+func (p *ArticlesPage) HasPrev() bool {
+	return p.Number() > 1
+}
+
+// Next fetches the page following this one.
+//
+// Parameters:
+//   - ctx: Context controlling cancellation of the request
+//
+// Returns:
+//   - *ArticlesPage: The next page
+//   - error: ErrNoPage if this is the last page, otherwise an error reporting issues in sending the request, handling the response, or parsing the JSON
+func (p *ArticlesPage) Next(ctx context.Context) (*ArticlesPage, error) {
+	if !p.HasNext() {
+		return nil, ErrNoPage
+	}
+
+	return p.client.GetArticlesPage(ctx, p.Number()+1, p.pageSize(), p.opts...)
+}
+
+// Prev fetches the page preceding this one.
+//
+// Parameters:
+//   - ctx: Context controlling cancellation of the request
+//
+// Returns:
+//   - *ArticlesPage: The previous page
+//   - error: ErrNoPage if this is the first page, otherwise an error reporting issues in sending the request, handling the response, or parsing the JSON
+func (p *ArticlesPage) Prev(ctx context.Context) (*ArticlesPage, error) {
+	if !p.HasPrev() {
+		return nil, ErrNoPage
+	}
+
+	return p.client.GetArticlesPage(ctx, p.Number()-1, p.pageSize(), p.opts...)
+}
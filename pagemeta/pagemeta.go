@@ -0,0 +1,204 @@
+// Package pagemeta builds Data Warehouse article requests from the metadata of web pages. It lives
+// in its own package so that only programs using it depend on the HTML parser.
+package pagemeta
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/0ffsideCompass/models"
+	"golang.org/x/net/html"
+)
+
+const (
+	// maxPageBytes bounds how much of a fetched page is parsed for metadata.
+	maxPageBytes = 2 << 20
+)
+
+// Metadata holds the metadata extracted from a web page.
+type Metadata struct {
+	Title       string
+	Description string
+	Tags        []string
+}
+
+// Extractor extracts metadata from an HTML page.
+type Extractor func(page io.Reader) (*Metadata, error)
+
+// Option configures NewArticleRequest.
+type Option func(*options)
+
+// options holds the settings collected from Option values.
+type options struct {
+	client  *http.Client
+	extract Extractor
+}
+
+// WithHTTPClient sets the HTTP client used to fetch the page. The default is http.DefaultClient.
+//
+// Parameters:
+//   - client: HTTP client fetching the page
+//
+// Returns:
+//   - Option: Option setting the HTTP client
+func WithHTTPClient(client *http.Client) Option {
+	return func(o *options) {
+		o.client = client
+	}
+}
+
+// WithExtractor replaces Extract as the function reading metadata from the page.
+//
+// Parameters:
+//   - extract: Function extracting the metadata
+//
+// Returns:
+//   - Option: Option setting the metadata extractor
+func WithExtractor(extract Extractor) Option {
+	return func(o *options) {
+		o.extract = extract
+	}
+}
+
+// NewArticleRequest fetches the page at pageURL and builds an article request from its metadata,
+// saving crawler-style callers from hand-building requests. The title is taken from the og:title meta
+// tag, falling back to the <title> element, and the tags from article:tag meta tags, falling back to
+// the comma-separated keywords meta tag. The request model has no description field, so the description
+// is only available by calling Extract directly. The page is fetched without the Data Warehouse API key and at
+// most the first 2 MiB are parsed.
+//
+// Parameters:
+//   - ctx: Context controlling cancellation of the page request
+//   - pageURL: URL of the page, also used as the article URL
+//   - opts: Optional settings such as WithExtractor
+//
+// Returns:
+//   - *models.DataWarehouseCreateArticleRequest: The populated article request
+//   - error: An error if the page could not be fetched, answered with a non-2xx status or has no title
+func NewArticleRequest(ctx context.Context, pageURL string, opts ...Option) (*models.DataWarehouseCreateArticleRequest, error) {
+	if pageURL == "" {
+		return nil, errors.New("url is empty")
+	}
+
+	config := &options{client: http.DefaultClient, extract: Extract}
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, pageURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating page request: %w", err)
+	}
+
+	res, err := config.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching page: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return nil, fmt.Errorf("error fetching page: unexpected status code: %d", res.StatusCode)
+	}
+
+	metadata, err := config.extract(io.LimitReader(res.Body, maxPageBytes))
+	if err != nil {
+		return nil, fmt.Errorf("error extracting page metadata: %w", err)
+	}
+	if metadata.Title == "" {
+		return nil, fmt.Errorf("page %s has no title", pageURL)
+	}
+
+	return &models.DataWarehouseCreateArticleRequest{
+		Title: metadata.Title,
+		URL:   pageURL,
+		Tags:  metadata.Tags,
+	}, nil
+}
+
+// Extract is the default Extractor. It reads OpenGraph and standard meta tags:
+// og:title or <title> for the title, og:description or description for the description, and
+// article:tag or keywords for the tags.
+//
+// Parameters:
+//   - page: The HTML page
+//
+// Returns:
+//   - *Metadata: The extracted metadata; fields without a matching tag are empty
+//   - error: An error if the page could not be parsed
+func Extract(page io.Reader) (*Metadata, error) {
+	doc, err := html.Parse(page)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		title, ogTitle             string
+		description, ogDescription string
+		articleTags, keywords      []string
+	)
+	for n := range doc.Descendants() {
+		if n.Type != html.ElementNode {
+			continue
+		}
+
+		switch n.Data {
+		case "title":
+			if title == "" && n.FirstChild != nil && n.FirstChild.Type == html.TextNode {
+				title = strings.TrimSpace(n.FirstChild.Data)
+			}
+		case "meta":
+			name := strings.ToLower(attr(n, "property"))
+			if name == "" {
+				name = strings.ToLower(attr(n, "name"))
+			}
+			content := strings.TrimSpace(attr(n, "content"))
+
+			switch name {
+			case "og:title":
+				ogTitle = content
+			case "og:description":
+				ogDescription = content
+			case "description":
+				description = content
+			case "article:tag":
+				if content != "" {
+					articleTags = append(articleTags, content)
+				}
+			case "keywords":
+				for _, keyword := range strings.Split(content, ",") {
+					if keyword = strings.TrimSpace(keyword); keyword != "" {
+						keywords = append(keywords, keyword)
+					}
+				}
+			}
+		}
+	}
+
+	metadata := &Metadata{Title: ogTitle, Description: ogDescription, Tags: articleTags}
+	if metadata.Title == "" {
+		metadata.Title = title
+	}
+	if metadata.Description == "" {
+		metadata.Description = description
+	}
+	if len(metadata.Tags) == 0 {
+		metadata.Tags = keywords
+	}
+
+	return metadata, nil
+}
+
+// attr returns the value of the attribute key of n, or an empty string if it is not set.
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,48 @@
+package pagemeta_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/0ffsideCompass/data-warehouse-go-client/pagemeta"
+)
+
+func TestNewArticleRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<html><head><title>Fallback</title>
+<meta property="og:title" content="Derby day">
+<meta property="article:tag" content="football">
+<meta property="article:tag" content="derby">
+<meta name="keywords" content="ignored">
+</head><body></body></html>`))
+	}))
+	defer server.Close()
+
+	request, err := pagemeta.NewArticleRequest(context.Background(), server.URL)
+	if err != nil {
+		t.Fatalf("NewArticleRequest() error = %v", err)
+	}
+	if request.Title != "Derby day" {
+		t.Errorf("Title = %q, want %q", request.Title, "Derby day")
+	}
+	if request.URL != server.URL {
+		t.Errorf("URL = %q, want %q", request.URL, server.URL)
+	}
+	if !slices.Equal(request.Tags, []string{"football", "derby"}) {
+		t.Errorf("Tags = %v, want [football derby]", request.Tags)
+	}
+}
+
+func TestNewArticleRequestWithoutTitle(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<html><head><meta name="keywords" content="a, b"></head></html>`))
+	}))
+	defer server.Close()
+
+	if _, err := pagemeta.NewArticleRequest(context.Background(), server.URL); err == nil {
+		t.Fatal("NewArticleRequest() error = nil, want an error for a page without title")
+	}
+}
@@ -0,0 +1,202 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// maxFetchPages bounds the number of pages fetchAllPages reads, so a bogus total reported by the
+	// server cannot make it issue an unbounded number of requests.
+	maxFetchPages = 100000
+)
+
+// pageQuery returns endpoint with page and limit query parameters.
+func pageQuery(endpoint string, page, limit int) string {
+	values := url.Values{}
+	values.Set("page", strconv.Itoa(page))
+	values.Set("limit", strconv.Itoa(limit))
+
+	return endpoint + "?" + values.Encode()
+}
+
+// appendQuery returns endpoint with values appended to its query string, which may already be present.
+func appendQuery(endpoint string, values url.Values) string {
+	separator := "?"
+	if strings.Contains(endpoint, "?") {
+		separator = "&"
+	}
+
+	return endpoint + separator + values.Encode()
+}
+
+// validatePage checks the page and limit arguments of list methods.
+func validatePage(page, limit int) error {
+	if page < 1 {
+		return errors.New("page must be at least 1")
+	}
+	if limit < 1 {
+		return errors.New("limit must be at least 1")
+	}
+
+	return nil
+}
+
+// pageFetcher fetches a single page, returning its items and the total reported by the server.
+type pageFetcher[T any] func(ctx context.Context, page int) ([]T, int, error)
+
+// fetchAllPages reads the first page to learn the total, then fetches the remaining pages concurrently
+// with at most concurrency requests in flight, assembling the items in page order.
+// Because the collection can change while it is being read, pages that come back short or empty are
+// accepted, and items seen on more than one page (shifted by concurrent inserts) are kept only once
+// using key; items with an empty key are never deduplicated. Items inserted after the first page was
+// read may be missed. The first failing page cancels the requests still in flight and no further pages
+// are started. A negative total, or one implying more than maxFetchPages pages, is rejected, and the
+// result is sized from the items actually received rather than from the reported total.
+//
+// Parameters:
+//   - ctx: Context controlling cancellation of the page requests
+//   - limit: Page size
+//   - concurrency: Maximum number of concurrent page requests
+//   - fetch: Function fetching one page
+//   - key: Function returning a unique key of an item
+//
+// Returns:
+//   - []T: All items in page order
+//   - error: The first error encountered while fetching a page
+func fetchAllPages[T any](ctx context.Context, limit, concurrency int, fetch pageFetcher[T], key func(T) string) ([]T, error) {
+	if err := validatePage(1, limit); err != nil {
+		return nil, err
+	}
+	if concurrency < 1 {
+		return nil, errors.New("concurrency must be at least 1")
+	}
+
+	first, total, err := fetch(ctx, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	if total < 0 {
+		return nil, fmt.Errorf("server reported a negative total of %d", total)
+	}
+	pages := total / limit
+	if total%limit != 0 {
+		pages++
+	}
+	if pages > maxFetchPages {
+		return nil, fmt.Errorf("server reported a total of %d, more than %d pages of %d", total, maxFetchPages, limit)
+	}
+	if pages <= 1 {
+		return first, nil
+	}
+
+	results := make([][]T, pages)
+	results[0] = first
+
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.SetLimit(concurrency)
+	for page := 2; page <= pages && groupCtx.Err() == nil; page++ {
+		group.Go(func() error {
+			items, _, err := fetch(groupCtx, page)
+			if err != nil {
+				return err
+			}
+			results[page-1] = items
+			return nil
+		})
+	}
+
+	if err := group.Wait(); err != nil {
+		return nil, err
+	}
+
+	received := 0
+	for _, items := range results {
+		received += len(items)
+	}
+
+	all := make([]T, 0, received)
+	seen := make(map[string]struct{}, received)
+	for _, items := range results {
+		for _, item := range items {
+			k := key(item)
+			if k != "" {
+				if _, ok := seen[k]; ok {
+					continue
+				}
+				seen[k] = struct{}{}
+			}
+			all = append(all, item)
+		}
+	}
+
+	return all, nil
+}
+
+// paginationFields holds the raw pagination counts of a paginated response so they can be decoded
+// tolerantly.
+type paginationFields struct {
+	Total json.RawMessage
+	Page  json.RawMessage
+	Limit json.RawMessage
+}
+
+// decode decodes the raw pagination counts into total, page and limit.
+func (f paginationFields) decode(total, page, limit *int) error {
+	for _, field := range []struct {
+		name  string
+		raw   json.RawMessage
+		value *int
+	}{
+		{"total", f.Total, total},
+		{"page", f.Page, page},
+		{"limit", f.Limit, limit},
+	} {
+		n, err := flexibleInt(field.raw)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %w", field.name, err)
+		}
+		*field.value = n
+	}
+
+	return nil
+}
+
+// flexibleInt decodes a pagination count sent as a JSON number, an integral float, or a string
+// holding either, guarding against server serialization quirks. Missing and null values decode as 0.
+func flexibleInt(raw json.RawMessage) (int, error) {
+	text := strings.TrimSpace(string(raw))
+	if text == "" || text == "null" {
+		return 0, nil
+	}
+
+	if strings.HasPrefix(text, `"`) {
+		if err := json.Unmarshal(raw, &text); err != nil {
+			return 0, err
+		}
+		text = strings.TrimSpace(text)
+	}
+
+	if n, err := strconv.Atoi(text); err == nil {
+		return n, nil
+	}
+
+	f, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%s is not a number", text)
+	}
+	if f != math.Trunc(f) {
+		return 0, fmt.Errorf("%s is not an integer", text)
+	}
+
+	return int(f), nil
+}
@@ -0,0 +1,134 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestFetchAllArticlesAssemblesPages(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		writeJSON(w, http.StatusOK, fmt.Sprintf(`{"articles":[{"id":"%d-a"},{"id":"%d-b"}],"total":5,"page":%d,"limit":2}`, page, page, page))
+	}))
+
+	articles, err := c.FetchAllArticles(context.Background(), 2, 2)
+	if err != nil {
+		t.Fatalf("FetchAllArticles() error = %v", err)
+	}
+	want := []string{"1-a", "1-b", "2-a", "2-b", "3-a", "3-b"}
+	if len(articles) != len(want) {
+		t.Fatalf("got %d articles, want %d", len(articles), len(want))
+	}
+	for i, article := range articles {
+		if article.ID != want[i] {
+			t.Errorf("articles[%d].ID = %q, want %q", i, article.ID, want[i])
+		}
+	}
+}
+
+func TestFetchAllArticlesDeduplicatesShiftedItems(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "1" {
+			writeJSON(w, http.StatusOK, `{"articles":[{"id":"a"},{"id":"b"}],"total":4}`)
+			return
+		}
+		writeJSON(w, http.StatusOK, `{"articles":[{"id":"b"},{"id":"c"}],"total":4}`)
+	}))
+
+	articles, err := c.FetchAllArticles(context.Background(), 2, 1)
+	if err != nil {
+		t.Fatalf("FetchAllArticles() error = %v", err)
+	}
+	if len(articles) != 3 {
+		t.Errorf("got %d articles, want 3", len(articles))
+	}
+}
+
+func TestFetchAllArticlesKeepsItemsWithoutID(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, `{"articles":[{"title":"one"},{"title":"two"}],"total":4}`)
+	}))
+
+	articles, err := c.FetchAllArticles(context.Background(), 2, 1)
+	if err != nil {
+		t.Fatalf("FetchAllArticles() error = %v", err)
+	}
+	if len(articles) != 4 {
+		t.Errorf("got %d articles, want 4", len(articles))
+	}
+}
+
+func TestFetchAllArticlesRejectsBogusTotal(t *testing.T) {
+	for _, total := range []string{"-1", "1000000000000"} {
+		t.Run(total, func(t *testing.T) {
+			var requests atomic.Int64
+			c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests.Add(1)
+				writeJSON(w, http.StatusOK, `{"articles":[{"id":"a"}],"total":`+total+`}`)
+			}))
+
+			if _, err := c.FetchAllArticles(context.Background(), 10, 4); err == nil {
+				t.Fatal("FetchAllArticles() error = nil, want an error")
+			}
+			if got := requests.Load(); got != 1 {
+				t.Errorf("sent %d requests, want only the first page", got)
+			}
+		})
+	}
+}
+
+func TestFetchAllArticlesCancelsPagesAfterError(t *testing.T) {
+	const concurrency = 4
+	started := make(chan struct{}, concurrency)
+	var cancelled, beyondWindow atomic.Int64
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		switch {
+		case page == 1:
+			writeJSON(w, http.StatusOK, `{"articles":[{"id":"1"}],"total":20}`)
+		case page == 2:
+			for i := 0; i < concurrency-1; i++ {
+				<-started
+			}
+			writeJSON(w, http.StatusInternalServerError, `{"error":"page 2 failed"}`)
+		default:
+			if page > concurrency+1 {
+				beyondWindow.Add(1)
+			}
+			started <- struct{}{}
+			select {
+			case <-r.Context().Done():
+				cancelled.Add(1)
+			case <-time.After(5 * time.Second):
+				writeJSON(w, http.StatusOK, `{"articles":[],"total":20}`)
+			}
+		}
+	}))
+
+	start := time.Now()
+	_, err := c.FetchAllArticles(context.Background(), 1, concurrency)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusInternalServerError {
+		t.Fatalf("FetchAllArticles() error = %v, want the page 2 *APIError", err)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("FetchAllArticles() took %s, want the failure to cancel the other pages", elapsed)
+	}
+
+	deadline := time.Now().Add(2 * time.Second)
+	for cancelled.Load() < concurrency-1 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if got := cancelled.Load(); got != concurrency-1 {
+		t.Errorf("%d in-flight page requests were cancelled, want %d", got, concurrency-1)
+	}
+	if got := beyondWindow.Load(); got != 0 {
+		t.Errorf("%d pages were requested after the failure", got)
+	}
+}
@@ -1,13 +1,19 @@
 package client
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"time"
 
 	"github.com/0ffsideCompass/models"
 )
 
 const (
 	createPodcastEndpoint = "/api/v1/podcasts"
+	podcastEndpoint       = "/api/v1/podcasts/{id}"
 )
 
 // CreatePodcast creates or updates a podcast in the Data Warehouse.
@@ -15,15 +21,334 @@ const (
 //
 // Parameters:
 //   - request: CreatePodcastRequest containing the podcast details
+//   - opts: Optional per-call settings such as WithContext
 //
 // Returns:
 //   - *Podcast: The created or updated podcast
 //   - error: An error object that reports issues either in sending the request, handling the response, or parsing the JSON
-func (c *Client) CreatePodcast(request models.DataWarehouseCreatePodcastRequest) error {
-	_, err := c.post(createPodcastEndpoint, request)
+func (c *Client) CreatePodcast(request models.DataWarehouseCreatePodcastRequest, opts ...RequestOption) error {
+	request = c.preparePodcastRequest(request)
+	if err := c.validateRequest(podcastRequest, request); err != nil {
+		return fmt.Errorf("error creating podcast: %w", err)
+	}
+
+	_, err := c.post(context.Background(), c.endpoint(OperationCreatePodcast), c.mapFields(request), append(opts, acceptStatus(http.StatusCreated), operation(OperationCreatePodcast), validatedBody(podcastRequest), bodyUnused())...)
 	if err != nil {
 		return fmt.Errorf("error creating podcast: %w", err)
 	}
 
 	return nil
 }
+
+// UpdatePodcast replaces an existing podcast in the Data Warehouse.
+// Pass WithIfMatch or WithIfUnmodifiedSince to make the update conditional on the version the caller
+// last read; if the podcast has changed in the meantime a *ConflictError is returned so the caller can
+// re-read and retry.
+//
+// Parameters:
+//   - id: ID of the podcast to update
+//   - request: CreatePodcastRequest containing the new podcast details
+//   - opts: Optional per-call settings such as preconditions
+//
+// Returns:
+//   - error: A *ConflictError if a precondition failed, otherwise an error reporting issues in sending the request or handling the response
+func (c *Client) UpdatePodcast(id string, request models.DataWarehouseCreatePodcastRequest, opts ...RequestOption) error {
+	if id == "" {
+		return errEmptyID
+	}
+
+	request = c.preparePodcastRequest(request)
+	if err := c.validateRequest(podcastRequest, request); err != nil {
+		return fmt.Errorf("error updating podcast: %w", err)
+	}
+
+	_, err := c.put(context.Background(), withID(c.endpoint(OperationUpdatePodcast), id), c.mapFields(request), append(opts, operation(OperationUpdatePodcast), validatedBody(podcastRequest))...)
+	if err != nil {
+		return fmt.Errorf("error updating podcast: %w", err)
+	}
+
+	return nil
+}
+
+// PodcastResponse represents the API response for a single podcast.
+type PodcastResponse struct {
+	Podcast *models.Podcast `json:"podcast"`
+}
+
+// GetPodcast retrieves the podcast with the given ID.
+//
+// Parameters:
+//   - id: ID of the podcast to retrieve
+//   - opts: Optional per-call settings such as WithContext
+//
+// Returns:
+//   - *models.Podcast: The podcast
+//   - error: A *NotFoundError if no podcast has the ID, ErrNoContent if the server answers 204 No Content, an *EmptyEntityError if the response carries no podcast, otherwise an error reporting issues in sending the request, handling the response, or parsing the JSON
+func (c *Client) GetPodcast(id string, opts ...RequestOption) (*models.Podcast, error) {
+	if id == "" {
+		return nil, errEmptyID
+	}
+
+	endpoint := withID(c.endpoint(OperationGetPodcast), id)
+	body, err := c.get(context.Background(), endpoint, append(opts, operation(OperationGetPodcast))...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting podcast: %w", asNotFound(err, "podcast", id))
+	}
+
+	response, err := parse[PodcastResponse](c, endpoint, body)
+	if err != nil {
+		return nil, fmt.Errorf("error getting podcast: %w", err)
+	}
+
+	if response.Podcast == nil {
+		return nil, &EmptyEntityError{Resource: "podcast"}
+	}
+
+	return response.Podcast, nil
+}
+
+// rawPodcastResponse captures the podcast of a PodcastResponse without decoding it.
+type rawPodcastResponse struct {
+	Podcast json.RawMessage `json:"podcast"`
+}
+
+// GetRawPodcast retrieves a single podcast by ID and returns its JSON exactly as sent by the server, without
+// decoding it into models.Podcast. This is useful for passthrough proxies and for fields the model does not
+// have yet. Authentication and status handling are the same as for GetPodcast; the response validator is
+// not applied because nothing is decoded. To forward the whole upstream response, including its headers
+// and error statuses, use GetPodcastResponse instead.
+//
+// Parameters:
+//   - id: ID of the podcast to retrieve
+//   - opts: Optional per-call settings such as WithContext
+//
+// Returns:
+//   - json.RawMessage: The undecoded podcast object
+//   - error: A *NotFoundError if no podcast has the ID, an *EmptyEntityError if the response carries no podcast, otherwise an error reporting issues in sending the request, handling the response, or parsing the JSON
+func (c *Client) GetRawPodcast(id string, opts ...RequestOption) (json.RawMessage, error) {
+	if id == "" {
+		return nil, errEmptyID
+	}
+
+	endpoint := withID(c.endpoint(OperationGetPodcast), id)
+	body, err := c.get(context.Background(), endpoint, append(opts, operation(OperationGetPodcast))...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting raw podcast: %w", asNotFound(err, "podcast", id))
+	}
+
+	var response rawPodcastResponse
+	if err := decodeJSON(endpoint, body, &response); err != nil {
+		return nil, fmt.Errorf("error getting raw podcast: %w", err)
+	}
+
+	if len(response.Podcast) == 0 || string(response.Podcast) == "null" {
+		return nil, &EmptyEntityError{Resource: "podcast"}
+	}
+
+	return response.Podcast, nil
+}
+
+// PodcastExists reports whether the podcast with the given ID exists in the Data Warehouse.
+// A HEAD request is used so no podcast data is transferred. If the server does not support HEAD
+// (405 Method Not Allowed or 501 Not Implemented) the check falls back to a GET of the same resource.
+//
+// Parameters:
+//   - id: ID of the podcast to look up
+//   - opts: Optional per-call settings such as WithContext
+//
+// Returns:
+//   - bool: True if the podcast exists
+//   - error: An error reporting issues in sending the request or an unexpected status code
+func (c *Client) PodcastExists(id string, opts ...RequestOption) (bool, error) {
+	if id == "" {
+		return false, errEmptyID
+	}
+
+	endpoint := withID(c.endpoint(OperationPodcastExists), id)
+	return c.exists(endpoint, append(opts, operation(OperationPodcastExists))...)
+}
+
+// GetPodcastByURL retrieves the podcast stored under the given URL.
+// Since creates key on URL, this is the natural way to read back a podcast that was just created.
+//
+// Parameters:
+//   - podcastURL: URL of the podcast to look up
+//   - opts: Optional per-call settings such as WithContext
+//
+// Returns:
+//   - *models.Podcast: The matching podcast
+//   - error: A *NotFoundError if no podcast has the URL, an error if several do, otherwise an error reporting issues in sending the request, handling the response, or parsing the JSON
+func (c *Client) GetPodcastByURL(podcastURL string, opts ...RequestOption) (*models.Podcast, error) {
+	if podcastURL == "" {
+		return nil, errors.New("url is empty")
+	}
+
+	endpoint := byURLEndpoint(c.endpoint(OperationGetPodcastByURL), podcastURL)
+	body, err := c.get(context.Background(), endpoint, append(opts, operation(OperationGetPodcastByURL))...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting podcast by url: %w", err)
+	}
+
+	response, err := parse[models.DataWarehousePodcastsResponse](c, endpoint, body)
+	if err != nil {
+		return nil, fmt.Errorf("error getting podcast by url: %w", err)
+	}
+
+	return singleMatch(response.Podcasts, "podcast", podcastURL)
+}
+
+// PaginatedPodcastsResponse represents one page of podcasts returned by ListPodcasts.
+type PaginatedPodcastsResponse struct {
+	Podcasts []models.Podcast `json:"podcasts"`
+	Total    int              `json:"total"`
+	Page     int              `json:"page"`
+	Limit    int              `json:"limit"`
+}
+
+// UnmarshalJSON decodes the response, accepting Total, Page and Limit as numbers or numeric strings.
+func (r *PaginatedPodcastsResponse) UnmarshalJSON(data []byte) error {
+	type alias PaginatedPodcastsResponse
+	aux := struct {
+		*alias
+		Total json.RawMessage `json:"total"`
+		Page  json.RawMessage `json:"page"`
+		Limit json.RawMessage `json:"limit"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	fields := paginationFields{Total: aux.Total, Page: aux.Page, Limit: aux.Limit}
+	return fields.decode(&r.Total, &r.Page, &r.Limit)
+}
+
+// ListPodcasts retrieves one page of podcasts.
+//
+// Parameters:
+//   - page: 1-based page number
+//   - limit: Maximum number of podcasts per page
+//   - opts: Optional per-call settings such as WithContext
+//
+// Returns:
+//   - *PaginatedPodcastsResponse: The page of podcasts together with the pagination metadata
+//   - error: An error object that reports issues either in sending the request, handling the response, or parsing the JSON
+func (c *Client) ListPodcasts(page, limit int, opts ...RequestOption) (*PaginatedPodcastsResponse, error) {
+	if err := validatePage(page, limit); err != nil {
+		return nil, err
+	}
+
+	endpoint := pageQuery(c.endpoint(OperationListPodcasts), page, limit)
+	body, err := c.get(context.Background(), endpoint, append(opts, operation(OperationListPodcasts))...)
+	if err != nil {
+		return nil, fmt.Errorf("error listing podcasts: %w", err)
+	}
+
+	response, err := parse[PaginatedPodcastsResponse](c, endpoint, body)
+	if err != nil {
+		return nil, fmt.Errorf("error listing podcasts: %w", err)
+	}
+
+	return response, nil
+}
+
+// ListPodcastsSorted retrieves one page of podcasts ordered by the given field, accepting the same
+// fields and orders as ListArticlesSorted.
+//
+// Parameters:
+//   - sortBy: Field to order by, one of id, title, created_at or updated_at
+//   - order: SortAsc or SortDesc
+//   - page: 1-based page number
+//   - limit: Maximum number of podcasts per page
+//   - opts: Optional per-call settings such as WithContext
+//
+// Returns:
+//   - *PaginatedPodcastsResponse: The page of podcasts together with the pagination metadata
+//   - error: An error if sortBy or order is not supported, or an error reporting issues in sending the request, handling the response, or parsing the JSON
+func (c *Client) ListPodcastsSorted(sortBy, order string, page, limit int, opts ...RequestOption) (*PaginatedPodcastsResponse, error) {
+	if err := validateSort(sortBy, order); err != nil {
+		return nil, err
+	}
+	if err := validatePage(page, limit); err != nil {
+		return nil, err
+	}
+
+	endpoint := sortQuery(pageQuery(c.endpoint(OperationListPodcasts), page, limit), sortBy, order)
+	body, err := c.get(context.Background(), endpoint, append(opts, operation(OperationListPodcasts))...)
+	if err != nil {
+		return nil, fmt.Errorf("error listing sorted podcasts: %w", err)
+	}
+
+	response, err := parse[PaginatedPodcastsResponse](c, endpoint, body)
+	if err != nil {
+		return nil, fmt.Errorf("error listing sorted podcasts: %w", err)
+	}
+
+	return response, nil
+}
+
+// GetPodcastsModifiedSince retrieves one page of the podcasts whose updated_at is at or after since,
+// for incremental syncs that only pull changed records. It applies the same timestamp formatting and
+// validation as GetArticlesModifiedSince.
+//
+// Parameters:
+//   - since: Lower bound, inclusive, of the podcasts' update time; must not be in the future according to the client's clock
+//   - page: 1-based page number
+//   - limit: Maximum number of podcasts per page
+//   - opts: Optional per-call settings such as WithContext
+//
+// Returns:
+//   - *PaginatedPodcastsResponse: The page of changed podcasts together with the pagination metadata
+//   - error: An error if since is zero or in the future, or an error reporting issues in sending the request, handling the response, or parsing the JSON
+func (c *Client) GetPodcastsModifiedSince(since time.Time, page, limit int, opts ...RequestOption) (*PaginatedPodcastsResponse, error) {
+	if err := c.validateSince(since); err != nil {
+		return nil, err
+	}
+	if err := validatePage(page, limit); err != nil {
+		return nil, err
+	}
+
+	endpoint := modifiedSinceQuery(pageQuery(c.endpoint(OperationListPodcasts), page, limit), since)
+	body, err := c.get(context.Background(), endpoint, append(opts, operation(OperationListPodcasts))...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting modified podcasts: %w", err)
+	}
+
+	response, err := parse[PaginatedPodcastsResponse](c, endpoint, body)
+	if err != nil {
+		return nil, fmt.Errorf("error getting modified podcasts: %w", err)
+	}
+
+	return response, nil
+}
+
+// FetchAllPodcasts retrieves every podcast by reading the first page to learn the total and then
+// fetching the remaining pages concurrently, with the same consistency guarantees as FetchAllArticles.
+// All podcasts are held in memory in a single slice, so for very large collections prefer paging
+// through ListPodcasts and processing each page as it arrives.
+//
+// Parameters:
+//   - ctx: Context controlling cancellation of the page requests
+//   - limit: Page size
+//   - concurrency: Maximum number of concurrent page requests
+//
+// Returns:
+//   - []models.Podcast: All podcasts
+//   - error: The first error encountered while fetching a page; it cancels the remaining page requests
+func (c *Client) FetchAllPodcasts(ctx context.Context, limit, concurrency int) ([]models.Podcast, error) {
+	fetch := func(ctx context.Context, page int) ([]models.Podcast, int, error) {
+		response, err := c.ListPodcasts(page, limit, WithContext(ctx))
+		if err != nil {
+			return nil, 0, err
+		}
+		return response.Podcasts, response.Total, nil
+	}
+
+	return fetchAllPages(ctx, limit, concurrency, fetch, func(p models.Podcast) string { return p.ID })
+}
+
+// preparePodcastRequest applies the client-wide request policy, such as default tags and tag normalization, to a podcast request.
+// The request is received by value and its Tags slice is rebuilt, so the caller's data is never mutated.
+func (c *Client) preparePodcastRequest(request models.DataWarehouseCreatePodcastRequest) models.DataWarehouseCreatePodcastRequest {
+	request.Tags = c.prepareTags(request.Tags)
+	return request
+}
@@ -0,0 +1,180 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestGetPodcastByURLEncodesURL(t *testing.T) {
+	for _, podcastURL := range []string{
+		"https://example.com/pods/derby",
+		"https://example.com/pods/derby?season=2&ep=10",
+		"https://example.com/pods/derby#chapter-3",
+		"https://example.com/pods/derby?q=a+b&x=%2F#frag ment",
+	} {
+		t.Run(podcastURL, func(t *testing.T) {
+			c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != createPodcastEndpoint {
+					t.Errorf("path = %q, want %q", r.URL.Path, createPodcastEndpoint)
+				}
+				if got := r.URL.Query().Get("url"); got != podcastURL {
+					t.Errorf("url query = %q, want %q", got, podcastURL)
+				}
+				writeJSON(w, http.StatusOK, `{"podcasts":[{"id":"p1","title":"Derby"}],"total":1}`)
+			}))
+
+			podcast, err := c.GetPodcastByURL(podcastURL)
+			if err != nil {
+				t.Fatalf("GetPodcastByURL() error = %v", err)
+			}
+			if podcast.ID != "p1" {
+				t.Errorf("ID = %q, want p1", podcast.ID)
+			}
+		})
+	}
+}
+
+func TestGetPodcastByURLMatches(t *testing.T) {
+	for name, test := range map[string]struct {
+		body     string
+		notFound bool
+	}{
+		"none":     {body: `{"podcasts":[],"total":0}`, notFound: true},
+		"multiple": {body: `{"podcasts":[{"id":"p1"},{"id":"p2"}],"total":2}`},
+	} {
+		t.Run(name, func(t *testing.T) {
+			c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				writeJSON(w, http.StatusOK, test.body)
+			}))
+
+			_, err := c.GetPodcastByURL("https://example.com/pods/derby")
+			if err == nil {
+				t.Fatal("GetPodcastByURL() error = nil, want an error")
+			}
+			var notFound *NotFoundError
+			if errors.As(err, &notFound) != test.notFound {
+				t.Errorf("GetPodcastByURL() error = %v, want NotFoundError: %t", err, test.notFound)
+			}
+		})
+	}
+}
+
+func TestFetchAllPodcasts(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		writeJSON(w, http.StatusOK, fmt.Sprintf(`{"podcasts":[{"id":"%d-a"},{"id":"%d-b"}],"total":6}`, page, page))
+	}))
+
+	podcasts, err := c.FetchAllPodcasts(context.Background(), 2, 3)
+	if err != nil {
+		t.Fatalf("FetchAllPodcasts() error = %v", err)
+	}
+	want := []string{"1-a", "1-b", "2-a", "2-b", "3-a", "3-b"}
+	if len(podcasts) != len(want) {
+		t.Fatalf("got %d podcasts, want %d", len(podcasts), len(want))
+	}
+	for i, podcast := range podcasts {
+		if podcast.ID != want[i] {
+			t.Errorf("podcasts[%d].ID = %q, want %q", i, podcast.ID, want[i])
+		}
+	}
+}
+
+func BenchmarkFetchAllPodcasts(b *testing.B) {
+	const pages, limit = 20, 50
+	c := newTestClient(b, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(time.Millisecond)
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		body := []byte(`{"podcasts":[`)
+		for i := 0; i < limit; i++ {
+			if i > 0 {
+				body = append(body, ',')
+			}
+			body = fmt.Appendf(body, `{"id":"%d-%d","title":"Episode"}`, page, i)
+		}
+		body = fmt.Appendf(body, `],"total":%d}`, pages*limit)
+		writeJSON(w, http.StatusOK, string(body))
+	}))
+
+	for _, concurrency := range []int{1, 4, 8} {
+		b.Run(fmt.Sprintf("concurrency=%d", concurrency), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := c.FetchAllPodcasts(context.Background(), limit, concurrency); err != nil {
+					b.Fatalf("FetchAllPodcasts() error = %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestGetPodcastsModifiedSince(t *testing.T) {
+	now := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	since := time.Date(2024, 3, 9, 14, 30, 15, 500, time.FixedZone("CET", 3600))
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query()["updated_since"]; len(got) != 1 || got[0] != "2024-03-09T13:30:15Z" {
+			t.Errorf("updated_since = %q, want [2024-03-09T13:30:15Z]", got)
+		}
+		if want := "limit=10&page=3&updated_since=2024-03-09T13%3A30%3A15Z"; r.URL.RawQuery != want {
+			t.Errorf("query = %q, want %q", r.URL.RawQuery, want)
+		}
+		writeJSON(w, http.StatusOK, `{"podcasts":[{"id":"p1"}],"total":21,"page":3,"limit":10}`)
+	}), WithClock(func() time.Time { return now }))
+
+	response, err := c.GetPodcastsModifiedSince(since, 3, 10)
+	if err != nil {
+		t.Fatalf("GetPodcastsModifiedSince() error = %v", err)
+	}
+	if response.Total != 21 || response.Page != 3 || response.Limit != 10 {
+		t.Errorf("total, page, limit = %d, %d, %d, want 21, 3, 10", response.Total, response.Page, response.Limit)
+	}
+	if len(response.Podcasts) != 1 || response.Podcasts[0].ID != "p1" {
+		t.Errorf("Podcasts = %+v", response.Podcasts)
+	}
+}
+
+func TestGetPodcastsModifiedSinceRejectsInvalidSince(t *testing.T) {
+	now := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s", r.URL)
+	}), WithClock(func() time.Time { return now }))
+
+	for name, since := range map[string]time.Time{"zero": {}, "future": now.Add(time.Minute)} {
+		if _, err := c.GetPodcastsModifiedSince(since, 1, 10); err == nil {
+			t.Errorf("%s: GetPodcastsModifiedSince() error = nil, want an error", name)
+		}
+	}
+}
+
+func TestListPodcastsSortedQuery(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if want := "limit=10&page=1&order=asc&sort=title"; r.URL.RawQuery != want {
+			t.Errorf("query = %q, want %q", r.URL.RawQuery, want)
+		}
+		writeJSON(w, http.StatusOK, `{"podcasts":[{"id":"p1"}],"total":1,"page":1,"limit":10}`)
+	}))
+
+	response, err := c.ListPodcastsSorted("title", SortAsc, 1, 10)
+	if err != nil {
+		t.Fatalf("ListPodcastsSorted() error = %v", err)
+	}
+	if len(response.Podcasts) != 1 || response.Total != 1 {
+		t.Errorf("response = %+v", response)
+	}
+}
+
+func TestListPodcastsSortedRejectsUnsupportedSort(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s", r.URL)
+	}))
+
+	for _, sort := range [][2]string{{"audio_url", SortAsc}, {"title", "ascending"}, {"", SortDesc}} {
+		if _, err := c.ListPodcastsSorted(sort[0], sort[1], 1, 10); err == nil {
+			t.Errorf("ListPodcastsSorted(%q, %q) error = nil, want an error", sort[0], sort[1])
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ClientPool manages one Client per Data Warehouse shard, keyed by base URL, so every shard gets its
+// own connection pool and per-client limits such as WithMaxConcurrentRequests. It is safe for
+// concurrent use. Close the pool to stop the background goroutines of its clients, such as the
+// WithHealthGate poller and the WithAsyncCreates workers.
+type ClientPool struct {
+	apiKey string
+	opts   []Option
+
+	mu      sync.Mutex
+	clients map[string]*Client
+	closed  bool
+}
+
+// NewClientPool returns a ClientPool creating its clients with the given API key and options.
+// The options are applied separately to every client, and every client gets its own transport, a clone
+// of http.DefaultTransport tuned by options such as WithIdleConnTimeout, so shards never share idle
+// connections or connection limits. Values passed to options, such as the Logger given
+// to WithLogger, are shared by all pooled clients.
+//
+// Parameters:
+//   - apiKey: API key for authenticating requests to every shard
+//   - opts: Options applied to every pooled client
+//
+// Returns:
+//   - *ClientPool: The new ClientPool
+//   - error: Error if the API key is empty
+func NewClientPool(apiKey string, opts ...Option) (*ClientPool, error) {
+	if apiKey == "" {
+		return nil, errors.New("apiKey is empty")
+	}
+
+	return &ClientPool{
+		apiKey:  apiKey,
+		opts:    append([]Option(nil), opts...),
+		clients: make(map[string]*Client),
+	}, nil
+}
+
+// ForHost returns the client for the shard at url, creating it with the pool's options on first use.
+// Later calls with the same url return the same client.
+//
+// Parameters:
+//   - url: Base URL of the shard
+//
+// Returns:
+//   - *Client: The client for the shard
+//   - error: ErrClientClosed after Close, or an error if the client could not be created, for example because an option is invalid
+func (p *ClientPool) ForHost(url string) (*Client, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed {
+		return nil, ErrClientClosed
+	}
+	if c, ok := p.clients[url]; ok {
+		return c, nil
+	}
+
+	c, err := New(url, p.apiKey, append([]Option{ownedTransport()}, p.opts...)...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating client for %s: %w", url, err)
+	}
+	p.clients[url] = c
+
+	return c, nil
+}
+
+// ownedTransport gives the client its own transport even when no option tunes it.
+func ownedTransport() Option {
+	return func(c *Client) error {
+		c.ownTransport()
+		return nil
+	}
+}
+
+// Remove closes the client for the shard at url, if the pool has one, and removes it from the pool.
+// A later ForHost call for url creates a new client.
+//
+// Parameters:
+//   - url: Base URL of the shard
+//
+// Returns:
+//   - error: The error returned by closing the client
+func (p *ClientPool) Remove(url string) error {
+	p.mu.Lock()
+	c, ok := p.clients[url]
+	delete(p.clients, url)
+	p.mu.Unlock()
+
+	if !ok {
+		return nil
+	}
+
+	return c.Close()
+}
+
+// Close closes every client of the pool, waiting for their queued async creates, and makes later
+// ForHost calls fail with ErrClientClosed. Calling Close more than once is safe.
+//
+// Returns:
+//   - error: The errors returned by closing the clients, joined
+func (p *ClientPool) Close() error {
+	p.mu.Lock()
+	clients := p.clients
+	p.clients = make(map[string]*Client)
+	p.closed = true
+	p.mu.Unlock()
+
+	var errs []error
+	for url, c := range clients {
+		if err := c.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("error closing client for %s: %w", url, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
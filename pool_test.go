@@ -0,0 +1,103 @@
+package client
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestClientPoolClosesClients(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, `{"status":"ok"}`)
+	}))
+	defer server.Close()
+
+	pool, err := NewClientPool("test-key", WithHealthGate(time.Hour, time.Hour), WithAsyncCreates(1, 1, AsyncBlock, nil))
+	if err != nil {
+		t.Fatalf("NewClientPool() error = %v", err)
+	}
+
+	first, err := pool.ForHost(server.URL)
+	if err != nil {
+		t.Fatalf("ForHost() error = %v", err)
+	}
+	if again, _ := pool.ForHost(server.URL); again != first {
+		t.Error("ForHost() returned a different client for the same URL")
+	}
+
+	if err := pool.Remove(server.URL); err != nil {
+		t.Fatalf("Remove() error = %v", err)
+	}
+	if err := first.CreateArticleAsync(testArticleRequest()); !errors.Is(err, ErrClientClosed) {
+		t.Errorf("CreateArticleAsync() on a removed client error = %v, want ErrClientClosed", err)
+	}
+
+	second, err := pool.ForHost(server.URL)
+	if err != nil {
+		t.Fatalf("ForHost() after Remove error = %v", err)
+	}
+	if second == first {
+		t.Error("ForHost() after Remove returned the removed client")
+	}
+
+	if err := pool.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	if err := second.CreateArticleAsync(testArticleRequest()); !errors.Is(err, ErrClientClosed) {
+		t.Errorf("CreateArticleAsync() after pool Close error = %v, want ErrClientClosed", err)
+	}
+	if _, err := pool.ForHost(server.URL); !errors.Is(err, ErrClientClosed) {
+		t.Errorf("ForHost() after Close error = %v, want ErrClientClosed", err)
+	}
+}
+
+func TestClientPoolTransportPerShard(t *testing.T) {
+	pool, err := NewClientPool("test-key", WithIdleConnTimeout(15*time.Second))
+	if err != nil {
+		t.Fatalf("NewClientPool() error = %v", err)
+	}
+	defer pool.Close()
+
+	first, err := pool.ForHost("http://shard-1.example.com")
+	if err != nil {
+		t.Fatalf("ForHost() error = %v", err)
+	}
+	second, err := pool.ForHost("http://shard-2.example.com")
+	if err != nil {
+		t.Fatalf("ForHost() error = %v", err)
+	}
+
+	firstTransport, secondTransport := httpTransport(t, first), httpTransport(t, second)
+	if firstTransport == secondTransport {
+		t.Error("pooled clients share a transport, want one per shard")
+	}
+	if firstTransport == http.DefaultTransport || secondTransport == http.DefaultTransport {
+		t.Error("pooled client uses http.DefaultTransport, want its own transport")
+	}
+	if firstTransport.IdleConnTimeout != 15*time.Second {
+		t.Errorf("IdleConnTimeout = %s, want the pool's options applied to the shard's transport", firstTransport.IdleConnTimeout)
+	}
+}
+
+func TestClientPoolTransportWithoutOptions(t *testing.T) {
+	pool, err := NewClientPool("test-key")
+	if err != nil {
+		t.Fatalf("NewClientPool() error = %v", err)
+	}
+	defer pool.Close()
+
+	first, err := pool.ForHost("http://shard-1.example.com")
+	if err != nil {
+		t.Fatalf("ForHost() error = %v", err)
+	}
+	second, err := pool.ForHost("http://shard-2.example.com")
+	if err != nil {
+		t.Fatalf("ForHost() error = %v", err)
+	}
+
+	if httpTransport(t, first) == httpTransport(t, second) {
+		t.Error("pooled clients share a transport, want one per shard")
+	}
+}
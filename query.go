@@ -0,0 +1,77 @@
+package client
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+)
+
+// WithDefaultQueryParams adds query parameters to every request URL, for example a region parameter
+// required by a gateway. Defaults are merged into the query string built for each call; a parameter
+// that the call already sets, such as page or limit, keeps the call's value. The parameters are only
+// ever sent in the URL, never in headers or request bodies.
+//
+// Parameters:
+//   - params: Query parameters added to every request
+//
+// Returns:
+//   - Option: Option setting the default query parameters
+func WithDefaultQueryParams(params url.Values) Option {
+	return func(c *Client) error {
+		for key := range params {
+			if key == "" {
+				return errors.New("default query parameter name is empty")
+			}
+		}
+
+		if c.defaultQuery == nil {
+			c.defaultQuery = url.Values{}
+		}
+		for key, values := range params {
+			c.defaultQuery[key] = append([]string(nil), values...)
+		}
+		return nil
+	}
+}
+
+// withCallQuery returns endpoint with the query parameters set by request options, such as WithInclude,
+// merged into its query string. Parameters set by options replace those already present.
+func withCallQuery(endpoint string, query url.Values) string {
+	if len(query) == 0 {
+		return endpoint
+	}
+
+	path, rawQuery, _ := strings.Cut(endpoint, "?")
+	values, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return endpoint
+	}
+
+	for key, value := range query {
+		values[key] = value
+	}
+
+	return path + "?" + values.Encode()
+}
+
+// withDefaultQuery returns endpoint with the client's default query parameters merged into its query
+// string. Parameters already present in endpoint take precedence over the defaults.
+func (c *Client) withDefaultQuery(endpoint string) string {
+	if len(c.defaultQuery) == 0 {
+		return endpoint
+	}
+
+	path, rawQuery, _ := strings.Cut(endpoint, "?")
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return endpoint
+	}
+
+	for key, values := range c.defaultQuery {
+		if _, ok := query[key]; !ok {
+			query[key] = values
+		}
+	}
+
+	return path + "?" + query.Encode()
+}
@@ -0,0 +1,137 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// GetArticleResponse retrieves the article with the given ID and returns the upstream response as is,
+// for gateways that forward it with its original headers such as Cache-Control and ETag. Unlike
+// GetArticle, and unlike GetRawArticle, which returns only the undecoded article object of a successful
+// response, the response is neither decoded nor converted into an error: any status code, including 404,
+// is returned together with its body and headers for the caller to forward. If the body was decompressed
+// under WithResponseCompression, Content-Encoding and Content-Length are removed from the returned headers
+// since they no longer describe it.
+//
+// Parameters:
+//   - id: ID of the article to retrieve
+//   - opts: Optional per-call settings such as WithContext
+//
+// Returns:
+//   - []byte: The response body
+//   - http.Header: The response headers
+//   - int: The response status code
+//   - error: An error reporting issues in sending the request or reading the response; never set because of the status code
+func (c *Client) GetArticleResponse(id string, opts ...RequestOption) ([]byte, http.Header, int, error) {
+	if id == "" {
+		return nil, nil, 0, errEmptyID
+	}
+
+	body, header, status, err := c.getResponse(context.Background(), withID(c.endpoint(OperationGetArticle), id), append(opts, operation(OperationGetArticle))...)
+	if err != nil {
+		return nil, nil, 0, fmt.Errorf("error getting article: %w", err)
+	}
+
+	return body, header, status, nil
+}
+
+// GetPodcastResponse retrieves the podcast with the given ID and returns the upstream response as is. It
+// behaves like GetArticleResponse.
+//
+// Parameters:
+//   - id: ID of the podcast to retrieve
+//   - opts: Optional per-call settings such as WithContext
+//
+// Returns:
+//   - []byte: The response body
+//   - http.Header: The response headers
+//   - int: The response status code
+//   - error: An error reporting issues in sending the request or reading the response; never set because of the status code
+func (c *Client) GetPodcastResponse(id string, opts ...RequestOption) ([]byte, http.Header, int, error) {
+	if id == "" {
+		return nil, nil, 0, errEmptyID
+	}
+
+	body, header, status, err := c.getResponse(context.Background(), withID(c.endpoint(OperationGetPodcast), id), append(opts, operation(OperationGetPodcast))...)
+	if err != nil {
+		return nil, nil, 0, fmt.Errorf("error getting podcast: %w", err)
+	}
+
+	return body, header, status, nil
+}
+
+// getResponse sends a GET request to endpoint and returns the body, headers and status code of the
+// response whatever its status. Retries, timeouts and concurrency limits apply as for get, and the call
+// is recorded in the expvar metrics if WithExpvar is set; only errors returned by the call count as
+// errors there, not the status code of the response.
+//
+// Parameters:
+//   - ctx: Context controlling cancellation of the request
+//   - endpoint: API endpoint to send the GET request to
+//   - opts: Optional per-call settings
+//
+// Returns:
+//   - []byte: Response body as a byte slice
+//   - http.Header: The response headers
+//   - int: The response status code
+//   - error: Error encountered while sending the request or reading the response
+func (c *Client) getResponse(ctx context.Context, endpoint string, opts ...RequestOption) ([]byte, http.Header, int, error) {
+	options := newRequestOptions(opts)
+	if err := c.checkHealthGate(options.operation); err != nil {
+		return nil, nil, 0, err
+	}
+
+	req, err := c.newRequest(ctx, http.MethodGet, endpoint, nil, options)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	start := time.Now()
+	body, header, status, err := c.exchangeResponse(req, options)
+	c.recordExpvar(options.operation, time.Since(start), err)
+
+	return body, header, status, err
+}
+
+// exchangeResponse sends a prepared request and returns the body, headers and status code of the
+// response whatever its status.
+//
+// Parameters:
+//   - req: The prepared request
+//   - options: Per-call settings of the request
+//
+// Returns:
+//   - []byte: Response body as a byte slice
+//   - http.Header: The response headers
+//   - int: The response status code
+//   - error: Error encountered while sending the request or reading the response
+func (c *Client) exchangeResponse(req *http.Request, options *requestOptions) ([]byte, http.Header, int, error) {
+	req, cancel := c.withDefaultTimeout(req, options.operation)
+	defer cancel()
+
+	release, err := c.acquire(req.Context())
+	if err != nil {
+		return nil, nil, 0, fmt.Errorf("error waiting for a request slot: %w", err)
+	}
+	defer release()
+
+	res, err := c.send(req)
+	if err != nil {
+		return nil, nil, 0, fmt.Errorf("error sending request: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := c.responseBody(res)
+	if err != nil {
+		return nil, nil, 0, fmt.Errorf("error reading response body: %w", err)
+	}
+
+	resBody, err := readBody(req.Context(), body, c.maxResponseBytes)
+	if err != nil {
+		return nil, nil, 0, fmt.Errorf("error reading response body: %w", err)
+	}
+
+	return resBody, res.Header.Clone(), res.StatusCode, nil
+}
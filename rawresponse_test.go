@@ -0,0 +1,56 @@
+package client
+
+import (
+	"encoding/json"
+	"expvar"
+	"net/http"
+	"testing"
+)
+
+// expvarCounts returns the requests and errors published for op under prefix.
+func expvarCounts(t *testing.T, prefix, op string) (requests, errors int64) {
+	t.Helper()
+
+	stats, ok := expvar.Get(prefix).(*expvar.Map)
+	if !ok {
+		t.Fatalf("expvar %s is not published", prefix)
+	}
+	v := stats.Get(op)
+	if v == nil {
+		return 0, 0
+	}
+
+	var counts struct {
+		Requests int64 `json:"requests"`
+		Errors   int64 `json:"errors"`
+	}
+	if err := json.Unmarshal([]byte(v.String()), &counts); err != nil {
+		t.Fatalf("decoding expvar %s.%s: %v", prefix, op, err)
+	}
+	return counts.Requests, counts.Errors
+}
+
+func TestRawResponsesRecordExpvar(t *testing.T) {
+	const prefix = "dwclient_test_raw_responses"
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/podcasts/missing" {
+			writeJSON(w, http.StatusNotFound, `{"error":"not found"}`)
+			return
+		}
+		writeJSON(w, http.StatusOK, `{"article":{"id":"a1"}}`)
+	}), WithExpvar(prefix))
+
+	if _, _, status, err := c.GetArticleResponse("a1"); err != nil || status != http.StatusOK {
+		t.Fatalf("GetArticleResponse() = %d, %v, want 200", status, err)
+	}
+	if _, _, status, err := c.GetPodcastResponse("missing"); err != nil || status != http.StatusNotFound {
+		t.Fatalf("GetPodcastResponse() = %d, %v, want 404 without an error", status, err)
+	}
+
+	if requests, errs := expvarCounts(t, prefix, OperationGetArticle); requests != 1 || errs != 0 {
+		t.Errorf("%s: requests = %d, errors = %d, want 1 and 0", OperationGetArticle, requests, errs)
+	}
+	if requests, errs := expvarCounts(t, prefix, OperationGetPodcast); requests != 1 || errs != 0 {
+		t.Errorf("%s: requests = %d, errors = %d, want the forwarded 404 counted as a request only", OperationGetPodcast, requests, errs)
+	}
+}
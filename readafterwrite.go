@@ -0,0 +1,62 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// readAfterWrite holds the settings of WithReadAfterWriteRetry.
+type readAfterWrite struct {
+	attempts int
+	delay    time.Duration
+}
+
+// WithReadAfterWriteRetry retries a read that fails with a *NotFoundError, for reading back a resource
+// right after creating it while the Data Warehouse may still be replicating the write. The read is
+// repeated up to attempts more times, waiting delay before each retry; a resource that is still missing
+// after the last retry is reported as not found. Waiting is bounded by the call's context, set with
+// WithContext. Only GetArticle and GetArticleByURL honor this option. Attempts of zero or less disable
+// it.
+//
+// Parameters:
+//   - attempts: Maximum number of retries after the first read
+//   - delay: Delay before each retry
+//
+// Returns:
+//   - RequestOption: Option enabling read-after-write retries
+func WithReadAfterWriteRetry(attempts int, delay time.Duration) RequestOption {
+	return func(o *requestOptions) {
+		o.readAfterWrite = readAfterWrite{attempts: attempts, delay: max(delay, 0)}
+	}
+}
+
+// retryNotFound calls read, repeating it while it fails with a *NotFoundError according to the
+// WithReadAfterWriteRetry setting found in opts.
+//
+// Parameters:
+//   - opts: Per-call settings of the read
+//   - read: Function performing the read
+//
+// Returns:
+//   - *T: The result of the first successful read
+//   - error: The error of the last read, or the context's error if it was done while waiting
+func retryNotFound[T any](opts []RequestOption, read func() (*T, error)) (*T, error) {
+	options := newRequestOptions(opts)
+	ctx := options.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	for attempt := 0; ; attempt++ {
+		result, err := read()
+		var notFound *NotFoundError
+		if err == nil || !errors.As(err, &notFound) || attempt >= options.readAfterWrite.attempts {
+			return result, err
+		}
+
+		if err := sleep(ctx, options.readAfterWrite.delay); err != nil {
+			return nil, err
+		}
+	}
+}
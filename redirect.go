@@ -0,0 +1,63 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+const (
+	// maxRedirects matches the limit of net/http's default redirect policy.
+	maxRedirects = 10
+)
+
+// RedirectPolicy controls how the client handles redirect responses.
+type RedirectPolicy int
+
+const (
+	// RedirectSameHostAuth follows redirects but only forwards the Authorization header when the
+	// redirect target has the same scheme and host, including port, as the original request. This is
+	// the default, and is stricter than net/http, which also forwards the header to subdomains.
+	RedirectSameHostAuth RedirectPolicy = iota
+	// RedirectNone does not follow redirects; a redirect response is returned as a *RedirectError
+	// carrying the Location header.
+	RedirectNone
+)
+
+// WithRedirectPolicy sets how redirect responses are handled. By default redirects are followed but
+// the API key is never sent to a different host, so a redirect cannot leak it to an unexpected server.
+// Use RedirectNone to fail on any redirect instead.
+//
+// Parameters:
+//   - policy: The redirect policy
+//
+// Returns:
+//   - Option: Option setting the redirect policy
+func WithRedirectPolicy(policy RedirectPolicy) Option {
+	return func(c *Client) error {
+		switch policy {
+		case RedirectSameHostAuth, RedirectNone:
+			c.redirectPolicy = policy
+			return nil
+		default:
+			return fmt.Errorf("unknown redirect policy %d", policy)
+		}
+	}
+}
+
+// checkRedirect implements http.Client.CheckRedirect according to the client's redirect policy.
+func (c *Client) checkRedirect(req *http.Request, via []*http.Request) error {
+	if c.redirectPolicy == RedirectNone {
+		return http.ErrUseLastResponse
+	}
+	if len(via) >= maxRedirects {
+		return errors.New("stopped after 10 redirects")
+	}
+
+	original := via[0].URL
+	if req.URL.Scheme != original.Scheme || req.URL.Host != original.Host {
+		req.Header.Del("Authorization")
+	}
+
+	return nil
+}
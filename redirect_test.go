@@ -0,0 +1,58 @@
+package client
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRedirectNoneReturnsRedirectError(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Location", "https://elsewhere.example.com/moved")
+		w.Header().Set("Content-Type", "text/html")
+		w.WriteHeader(http.StatusFound)
+		w.Write([]byte(`<a href="https://elsewhere.example.com/moved">Found</a>`))
+	}), WithRedirectPolicy(RedirectNone))
+
+	_, err := c.GetArticle("1")
+	var redirectErr *RedirectError
+	if !errors.As(err, &redirectErr) {
+		t.Fatalf("GetArticle() error = %v, want *RedirectError", err)
+	}
+	if redirectErr.StatusCode != http.StatusFound || redirectErr.Location != "https://elsewhere.example.com/moved" {
+		t.Errorf("RedirectError = %+v", redirectErr)
+	}
+	var gatewayErr *GatewayError
+	if !errors.As(err, &gatewayErr) {
+		t.Errorf("RedirectError does not wrap a *GatewayError for an HTML body: %v", err)
+	}
+}
+
+func TestRedirectSameHostAuthStripsAuthorization(t *testing.T) {
+	other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if auth := r.Header.Get("Authorization"); auth != "" {
+			t.Errorf("Authorization %q forwarded to another host", auth)
+		}
+		writeJSON(w, http.StatusOK, `{"article":{"id":"1"}}`)
+	}))
+	defer other.Close()
+
+	var sameHostAuth string
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/articles/1":
+			http.Redirect(w, r, "/api/v1/articles/2", http.StatusFound)
+		case "/api/v1/articles/2":
+			sameHostAuth = r.Header.Get("Authorization")
+			http.Redirect(w, r, other.URL+"/api/v1/articles/1", http.StatusFound)
+		}
+	}))
+
+	if _, err := c.GetArticle("1"); err != nil {
+		t.Fatalf("GetArticle() error = %v", err)
+	}
+	if sameHostAuth != "Bearer test-key" {
+		t.Errorf("Authorization on same-host redirect = %q, want it forwarded", sameHostAuth)
+	}
+}
@@ -0,0 +1,191 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"sync"
+
+	"github.com/0ffsideCompass/models"
+)
+
+const (
+	// renameTagPageSize is the page size used to list the resources carrying the tag being renamed.
+	renameTagPageSize = 100
+	// renameTagConcurrency is the number of tag updates RenameArticleTag and RenamePodcastTag keep in flight.
+	renameTagConcurrency = 4
+)
+
+// tagsPatch is the request body of a partial update that only replaces the tags of a resource.
+type tagsPatch struct {
+	Tags []string `json:"tags"`
+}
+
+// RenameArticleTag replaces oldTag with newTag on every article carrying it. All matching articles are
+// listed with the tag filter before any is updated, so renamed articles dropping out of the filter do
+// not shift the pages. Each article is then updated with a PATCH that only sends its new tags, with a
+// bounded number of updates in flight; an article that already carries newTag keeps it once. The tag
+// normalizer, if any, is applied to the new tags, default tags are not added.
+// A failed update does not stop the others: the call returns the number of articles updated together
+// with a *TagRenameError listing the articles that could not be updated.
+//
+// Parameters:
+//   - ctx: Context controlling cancellation of the requests
+//   - oldTag: Tag to replace
+//   - newTag: Tag to replace it with
+//
+// Returns:
+//   - int: Number of articles updated
+//   - error: A *TagRenameError if some updates failed, otherwise an error if the tags are invalid or listing the articles failed
+func (c *Client) RenameArticleTag(ctx context.Context, oldTag, newTag string) (int, error) {
+	fetch := func(ctx context.Context, page int) ([]models.Article, int, error) {
+		endpoint := tagQuery(pageQuery(c.endpoint(OperationListArticles), page, renameTagPageSize), oldTag)
+		body, err := c.get(ctx, endpoint, operation(OperationListArticles))
+		if err != nil {
+			return nil, 0, err
+		}
+		response, err := parse[PaginatedArticlesResponse](c, endpoint, body)
+		if err != nil {
+			return nil, 0, err
+		}
+		return response.Articles, response.Total, nil
+	}
+	tags := func(a models.Article) (string, []string) { return a.ID, a.Tags }
+
+	return renameTag(ctx, c, "article", OperationPatchArticleTags, oldTag, newTag, fetch, tags)
+}
+
+// RenamePodcastTag replaces oldTag with newTag on every podcast carrying it. It behaves like
+// RenameArticleTag.
+//
+// Parameters:
+//   - ctx: Context controlling cancellation of the requests
+//   - oldTag: Tag to replace
+//   - newTag: Tag to replace it with
+//
+// Returns:
+//   - int: Number of podcasts updated
+//   - error: A *TagRenameError if some updates failed, otherwise an error if the tags are invalid or listing the podcasts failed
+func (c *Client) RenamePodcastTag(ctx context.Context, oldTag, newTag string) (int, error) {
+	fetch := func(ctx context.Context, page int) ([]models.Podcast, int, error) {
+		endpoint := tagQuery(pageQuery(c.endpoint(OperationListPodcasts), page, renameTagPageSize), oldTag)
+		body, err := c.get(ctx, endpoint, operation(OperationListPodcasts))
+		if err != nil {
+			return nil, 0, err
+		}
+		response, err := parse[PaginatedPodcastsResponse](c, endpoint, body)
+		if err != nil {
+			return nil, 0, err
+		}
+		return response.Podcasts, response.Total, nil
+	}
+	tags := func(p models.Podcast) (string, []string) { return p.ID, p.Tags }
+
+	return renameTag(ctx, c, "podcast", OperationPatchPodcastTags, oldTag, newTag, fetch, tags)
+}
+
+// renameTag lists every resource carrying oldTag and patches its tags, replacing oldTag with newTag.
+//
+// Parameters:
+//   - ctx: Context controlling cancellation of the requests
+//   - c: Client sending the requests
+//   - resource: Name of the resource used in errors
+//   - op: Operation patching the tags of one resource
+//   - oldTag: Tag to replace
+//   - newTag: Tag to replace it with
+//   - fetch: Function fetching one page of the resources carrying oldTag
+//   - tags: Function returning the ID and tags of a resource
+//
+// Returns:
+//   - int: Number of resources updated
+//   - error: A *TagRenameError if some updates failed, otherwise an error if the tags are invalid or listing failed
+func renameTag[T any](ctx context.Context, c *Client, resource, op, oldTag, newTag string, fetch pageFetcher[T], tags func(T) (string, []string)) (int, error) {
+	if oldTag == "" || newTag == "" {
+		return 0, errors.New("tags must not be empty")
+	}
+	if oldTag == newTag {
+		return 0, errors.New("old and new tag are the same")
+	}
+
+	key := func(item T) string {
+		id, _ := tags(item)
+		return id
+	}
+	items, err := fetchAllPages(ctx, renameTagPageSize, renameTagConcurrency, fetch, key)
+	if err != nil {
+		return 0, fmt.Errorf("error listing %ss tagged %s: %w", resource, oldTag, err)
+	}
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		updated int
+		failed  = map[string]error{}
+		sem     = make(chan struct{}, renameTagConcurrency)
+	)
+	for _, item := range items {
+		id, current := tags(item)
+		if !slices.Contains(current, oldTag) {
+			// The server may match tags loosely; only rename exact matches.
+			continue
+		}
+
+		if err := acquireSlot(ctx, sem); err != nil {
+			mu.Lock()
+			failed[id] = err
+			mu.Unlock()
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			patch := tagsPatch{Tags: c.renamedTags(current, oldTag, newTag)}
+			_, err := c.patch(ctx, withID(c.endpoint(op), id), patch, operation(op))
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				failed[id] = asNotFound(err, resource, id)
+				return
+			}
+			updated++
+		}()
+	}
+	wg.Wait()
+
+	if len(failed) > 0 {
+		return updated, &TagRenameError{Resource: resource, OldTag: oldTag, NewTag: newTag, Failed: failed}
+	}
+
+	return updated, nil
+}
+
+// renamedTags returns a copy of tags with oldTag replaced by newTag, keeping every tag once, with the
+// tag normalizer applied if one is configured.
+func (c *Client) renamedTags(tags []string, oldTag, newTag string) []string {
+	renamed := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if tag == oldTag {
+			tag = newTag
+		}
+		if !slices.Contains(renamed, tag) {
+			renamed = append(renamed, tag)
+		}
+	}
+
+	if c.tagNormalizer == nil {
+		return renamed
+	}
+
+	return normalizeTags(renamed, c.tagNormalizer)
+}
+
+// tagQuery returns endpoint with a tag query parameter filtering the listed resources by tag.
+func tagQuery(endpoint, tag string) string {
+	return appendQuery(endpoint, url.Values{"tag": {tag}})
+}
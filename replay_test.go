@@ -0,0 +1,48 @@
+package client
+
+import (
+	"compress/gzip"
+	"net/http"
+	"testing"
+)
+
+func TestRecordAndReplayCompressedResponse(t *testing.T) {
+	dir := t.TempDir()
+	recorder := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Encoding", "gzip")
+		gz := gzip.NewWriter(w)
+		gz.Write([]byte(`{"article":{"id":"1","title":"Derby day"}}`))
+		gz.Close()
+	}), WithRecordTo(dir), WithResponseCompression())
+
+	if _, err := recorder.GetArticle("1"); err != nil {
+		t.Fatalf("recording GetArticle() error = %v", err)
+	}
+
+	replayer, err := New("http://replay.invalid", "test-key", WithReplayFromDir(dir), WithResponseCompression())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer replayer.Close()
+
+	article, err := replayer.GetArticle("1")
+	if err != nil {
+		t.Fatalf("replayed GetArticle() error = %v", err)
+	}
+	if article.Title != "Derby day" {
+		t.Errorf("Title = %q, want %q", article.Title, "Derby day")
+	}
+}
+
+func TestReplayMissingCassette(t *testing.T) {
+	c, err := New("http://replay.invalid", "test-key", WithReplayFromDir(t.TempDir()))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer c.Close()
+
+	if _, err := c.GetArticle("1"); err == nil {
+		t.Fatal("GetArticle() error = nil, want a missing cassette error")
+	}
+}
@@ -0,0 +1,73 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	// baseURLCacheTTL is how long a base URL returned by the resolver is reused before resolving again.
+	baseURLCacheTTL = 30 * time.Second
+)
+
+// baseURLResolver resolves the base URL through a user-supplied function and caches the result.
+type baseURLResolver struct {
+	resolve func(ctx context.Context) (string, error)
+
+	mu      sync.Mutex
+	url     string
+	expires time.Time
+}
+
+// WithBaseURLResolver resolves the base URL per request, for example through service discovery, instead
+// of using the URL passed to New, so the client follows endpoint changes without being recreated.
+// A resolved URL is reused for 30 seconds. If resolution fails the request fails with the resolver's
+// error. The URL passed to New is still required and identifies the client, for example in MultiClient.
+//
+// Parameters:
+//   - resolve: Function returning the current base URL
+//
+// Returns:
+//   - Option: Option setting the base URL resolver
+func WithBaseURLResolver(resolve func(ctx context.Context) (string, error)) Option {
+	return func(c *Client) error {
+		if resolve == nil {
+			return errors.New("base url resolver is nil")
+		}
+		c.resolver = &baseURLResolver{resolve: resolve}
+		return nil
+	}
+}
+
+// baseURL returns the base URL for a request, resolving it if a resolver is configured and the cached
+// value has expired.
+func (c *Client) baseURL(ctx context.Context) (string, error) {
+	if c.resolver == nil {
+		return c.url, nil
+	}
+
+	r := c.resolver
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := c.now()
+	if r.url != "" && now.Before(r.expires) {
+		return r.url, nil
+	}
+
+	resolved, err := r.resolve(ctx)
+	if err != nil {
+		return "", fmt.Errorf("error resolving base url: %w", err)
+	}
+	if resolved == "" {
+		return "", errors.New("error resolving base url: resolver returned an empty url")
+	}
+
+	r.url = resolved
+	r.expires = now.Add(baseURLCacheTTL)
+
+	return r.url, nil
+}
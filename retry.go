@@ -0,0 +1,380 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// retryBudgetMaxTokens is the capacity of the client-wide retry budget.
+	retryBudgetMaxTokens = 100
+)
+
+// retryPolicy controls how many times a failed request is retried and how long to wait in between.
+type retryPolicy struct {
+	maxRetries int
+	baseDelay  time.Duration
+	maxDelay   time.Duration
+	predicate  RetryPredicate
+}
+
+// retryBudget is a client-wide token bucket bounding the total number of retries across all in-flight
+// requests, modelled after gRPC retry throttling. Every failed attempt removes one token and every
+// successful attempt adds ratio tokens; retries are only allowed while more than half the tokens remain.
+type retryBudget struct {
+	mu     sync.Mutex
+	tokens float64
+	ratio  float64
+}
+
+// WithRetry retries requests that fail with a connection error or a retryable status (429 or 5xx other
+// than 501) up to maxRetries times, waiting an exponentially growing, jittered delay starting at baseDelay.
+// GET, HEAD, OPTIONS, PUT, PATCH and DELETE requests are retried; POST requests are only retried when sent
+// with WithIdempotent, because retrying a create can produce duplicates.
+// When WithRetryBudget is also set, each retry must additionally be allowed by the client-wide budget.
+//
+// Parameters:
+//   - maxRetries: Maximum number of retries after the first attempt
+//   - baseDelay: Delay before the first retry
+//
+// Returns:
+//   - Option: Option enabling retries
+func WithRetry(maxRetries int, baseDelay time.Duration) Option {
+	return func(c *Client) error {
+		if maxRetries < 0 {
+			return errors.New("maxRetries must not be negative")
+		}
+		if baseDelay < 0 {
+			return errors.New("baseDelay must not be negative")
+		}
+		c.retry.maxRetries = maxRetries
+		c.retry.baseDelay = baseDelay
+		return nil
+	}
+}
+
+// WithMaxRetryDelay caps the exponential delay between retries under WithRetry, so long outages do
+// not produce sleeps of minutes. The cap applies before jitter, so retries at the cap still wait a
+// random delay between half of it and all of it, and clients do not retry in lockstep.
+//
+// Parameters:
+//   - d: Maximum delay before a retry
+//
+// Returns:
+//   - Option: Option setting the maximum retry delay
+func WithMaxRetryDelay(d time.Duration) Option {
+	return func(c *Client) error {
+		if d <= 0 {
+			return errors.New("max retry delay must be positive")
+		}
+		c.retry.maxDelay = d
+		return nil
+	}
+}
+
+// WithRetryBudget bounds the sum of retries across all requests made by the client, so a struggling
+// backend is not overwhelmed by retries under high concurrency. Each failed attempt spends one token
+// and each successful attempt earns ratio tokens; once fewer than half of the tokens remain, requests
+// fail fast with their last error instead of retrying. The budget only limits retries configured with
+// WithRetry, it never adds retries of its own.
+//
+// Parameters:
+//   - ratio: Tokens earned per successful attempt, between 0 (exclusive) and 1 (inclusive)
+//
+// Returns:
+//   - Option: Option enabling the retry budget
+func WithRetryBudget(ratio float64) Option {
+	return func(c *Client) error {
+		if ratio <= 0 || ratio > 1 {
+			return errors.New("retry budget ratio must be in (0, 1]")
+		}
+		c.retryBudget = &retryBudget{tokens: retryBudgetMaxTokens, ratio: ratio}
+		return nil
+	}
+}
+
+// onSuccess returns tokens to the budget after a successful attempt.
+func (b *retryBudget) onSuccess() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.tokens = min(b.tokens+b.ratio, retryBudgetMaxTokens)
+}
+
+// onFailure spends a token after a failed attempt and reports whether a retry is still allowed.
+func (b *retryBudget) onFailure() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.tokens = max(b.tokens-1, 0)
+	return b.tokens > retryBudgetMaxTokens/2
+}
+
+// send executes req, retrying it according to the client's retry policy and budget.
+// The request body, if any, must be replayable through req.GetBody, which http.NewRequest sets up for
+// in-memory bodies.
+//
+// Parameters:
+//   - req: The request to send
+//
+// Returns:
+//   - *http.Response: The final response; the caller must close its body
+//   - error: The final transport error, or an error if the context was cancelled while waiting to retry
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+
+		res, err := c.attempt(req, attempt+1)
+		if !c.shouldRetry(req, res, err, attempt) {
+			return res, err
+		}
+
+		delay := c.backoff(attempt)
+		if c.onRetry != nil {
+			cause := err
+			if cause == nil {
+				cause = c.errorFromResponse(res, nil)
+			}
+			c.onRetry(attempt+1, cause, delay)
+		}
+
+		if res != nil {
+			io.Copy(io.Discard, res.Body)
+			res.Body.Close()
+		}
+
+		if err := sleep(req.Context(), delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// shouldRetry decides whether the outcome of an attempt warrants another attempt.
+func (c *Client) shouldRetry(req *http.Request, res *http.Response, err error, attempt int) bool {
+	var intercepted *interceptorError
+	if errors.As(err, &intercepted) {
+		return false
+	}
+
+	failed := c.retryable(res, err)
+	if c.retryBudget != nil {
+		if !failed {
+			c.retryBudget.onSuccess()
+			return false
+		}
+		if !c.retryBudget.onFailure() {
+			return false
+		}
+	}
+
+	if !failed || attempt >= c.retry.maxRetries || !isRetrySafe(req) || !isReplayable(req) {
+		return false
+	}
+
+	return req.Context().Err() == nil
+}
+
+// backoff returns the jittered exponential delay to wait before the retry following attempt. The
+// exponential delay is clamped to the WithMaxRetryDelay cap before jitter is applied; without a cap it
+// saturates at the largest time.Duration instead of overflowing, so a positive base delay never
+// yields a zero delay.
+func (c *Client) backoff(attempt int) time.Duration {
+	base := c.retry.baseDelay
+	if base <= 0 {
+		return 0
+	}
+
+	limit := c.retry.maxDelay
+	if limit <= 0 {
+		limit = math.MaxInt64
+	}
+
+	delay := limit
+	if attempt < 63 && base <= limit>>attempt {
+		delay = base << attempt
+	}
+
+	half := int64(delay / 2)
+	if half == 0 {
+		return delay
+	}
+
+	return time.Duration(half + c.jitter.int63n(half+1))
+}
+
+// jitterSource draws the random jitter of retry delays. A nil *jitterSource uses math/rand's global
+// source, which the runtime seeds from the operating system's random number generator.
+type jitterSource struct {
+	mu     sync.Mutex
+	random *rand.Rand
+}
+
+// WithRandSource draws the jitter of retry delays from source instead of a randomly seeded source,
+// so that tests can seed it and assert exact delay sequences. Access to source is serialized, so it
+// need not be safe for concurrent use. Do not use a fixed seed in production: clients sharing a seed
+// retry in lockstep, which is what jitter is meant to prevent.
+//
+// Parameters:
+//   - source: Source of randomness for retry jitter
+//
+// Returns:
+//   - Option: Option setting the jitter source
+func WithRandSource(source rand.Source) Option {
+	return func(c *Client) error {
+		if source == nil {
+			return errors.New("rand source is nil")
+		}
+		c.jitter = &jitterSource{random: rand.New(source)}
+		return nil
+	}
+}
+
+// int63n returns a random number in [0, n).
+func (j *jitterSource) int63n(n int64) int64 {
+	if j == nil {
+		return rand.Int63n(n)
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.random.Int63n(n)
+}
+
+// WithOnRetry invokes onRetry before every retry, for example to count retries in a metric or log
+// them, without writing middleware. It is called for retries after transport errors and after
+// retryable status codes; in the latter case err is the typed error for the status, such as an
+// *APIError or a *RateLimitError, without the response body.
+//
+// Parameters:
+//   - onRetry: Callback receiving the 1-based number of the upcoming retry, the failure that caused it and the delay before it is sent
+//
+// Returns:
+//   - Option: Option setting the retry callback
+func WithOnRetry(onRetry func(attempt int, err error, nextDelay time.Duration)) Option {
+	return func(c *Client) error {
+		if onRetry == nil {
+			return errors.New("retry callback is nil")
+		}
+		c.onRetry = onRetry
+		return nil
+	}
+}
+
+// RetryPredicate decides whether the outcome of an attempt is a transient failure worth retrying.
+// Exactly one of res and err is non-nil. The predicate must not read or close the response body, which
+// is still owned by the caller or by the retry loop.
+type RetryPredicate func(res *http.Response, err error) bool
+
+// WithRetryPredicate replaces the rules deciding which failures are retried under WithRetry.
+// The predicate only decides whether an outcome is retryable; method safety, replayable bodies, the
+// retry limit, the retry budget and context cancellation still apply. Compose with
+// DefaultRetryPredicate to extend rather than replace the default rules.
+//
+// Parameters:
+//   - predicate: Function reporting whether an attempt should be retried
+//
+// Returns:
+//   - Option: Option setting the retry predicate
+func WithRetryPredicate(predicate RetryPredicate) Option {
+	return func(c *Client) error {
+		if predicate == nil {
+			return errors.New("retry predicate is nil")
+		}
+		c.retry.predicate = predicate
+		return nil
+	}
+}
+
+// DefaultRetryPredicate is the retry predicate used unless WithRetryPredicate is set. It retries
+// connection errors, 429 Too Many Requests and 5xx responses other than 501 Not Implemented.
+//
+// Parameters:
+//   - res: The response of the attempt, or nil if it failed
+//   - err: The transport error of the attempt, or nil if a response was received
+//
+// Returns:
+//   - bool: True if the attempt should be retried
+func DefaultRetryPredicate(res *http.Response, err error) bool {
+	return err != nil || isRetryableStatus(res.StatusCode)
+}
+
+// retryable reports whether the outcome of an attempt is a failure according to the client's retry predicate.
+func (c *Client) retryable(res *http.Response, err error) bool {
+	if c.retry.predicate != nil {
+		return c.retry.predicate(res, err)
+	}
+
+	return DefaultRetryPredicate(res, err)
+}
+
+// isRetryableStatus reports whether a response status code indicates a transient failure.
+func isRetryableStatus(code int) bool {
+	return code == http.StatusTooManyRequests || (code >= 500 && code != http.StatusNotImplemented)
+}
+
+// retrySafeMethods lists the HTTP methods retried by default because repeating them has the same effect as
+// sending them once. POST is deliberately absent: retrying a create can produce duplicates, so POST
+// requests are only retried when the caller opts in with WithIdempotent.
+var retrySafeMethods = map[string]bool{
+	http.MethodGet:     true,
+	http.MethodHead:    true,
+	http.MethodOptions: true,
+	http.MethodPut:     true,
+	http.MethodPatch:   true,
+	http.MethodDelete:  true,
+}
+
+// idempotentKey is the context key marking a request the caller declared safe to retry.
+type idempotentKey struct{}
+
+// WithIdempotent declares that the request is safe to repeat, allowing it to be retried under WithRetry
+// even when its method is not retried by default, such as a POST the server deduplicates.
+//
+// Returns:
+//   - RequestOption: Option marking the request as idempotent
+func WithIdempotent() RequestOption {
+	return func(o *requestOptions) {
+		o.idempotent = true
+	}
+}
+
+// isRetrySafe reports whether req may be retried, either because its method is in retrySafeMethods or
+// because it was sent with WithIdempotent.
+func isRetrySafe(req *http.Request) bool {
+	if retrySafeMethods[req.Method] {
+		return true
+	}
+
+	idempotent, _ := req.Context().Value(idempotentKey{}).(bool)
+	return idempotent
+}
+
+// isReplayable reports whether req can be sent again, i.e. it has no body or its body can be recreated.
+func isReplayable(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// sleep waits for d or until ctx is done, whichever comes first.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
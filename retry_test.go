@@ -0,0 +1,215 @@
+package client
+
+import (
+	"errors"
+	"io"
+	"math"
+	"math/rand"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestBackoffNeverOverflows(t *testing.T) {
+	c := &Client{retry: retryPolicy{baseDelay: time.Second}, jitter: &jitterSource{random: rand.New(rand.NewSource(1))}}
+	for attempt := 0; attempt < 200; attempt++ {
+		if delay := c.backoff(attempt); delay <= 0 {
+			t.Fatalf("backoff(%d) = %s, want a positive delay", attempt, delay)
+		}
+	}
+	if delay := c.backoff(100); delay < math.MaxInt64/2 {
+		t.Errorf("backoff(100) = %s, want a saturated delay", delay)
+	}
+}
+
+func TestBackoffHonorsMaxDelay(t *testing.T) {
+	c := &Client{retry: retryPolicy{baseDelay: 100 * time.Millisecond, maxDelay: time.Second}, jitter: &jitterSource{random: rand.New(rand.NewSource(1))}}
+	for _, attempt := range []int{0, 3, 4, 10, 62, 63, 64, 1000} {
+		delay := c.backoff(attempt)
+		if delay <= 0 || delay > time.Second {
+			t.Errorf("backoff(%d) = %s, want a delay in (0, 1s]", attempt, delay)
+		}
+	}
+	if delay := c.backoff(10); delay < 500*time.Millisecond {
+		t.Errorf("backoff(10) = %s, want at least half of the cap", delay)
+	}
+}
+
+func TestBackoffTinyBaseDelay(t *testing.T) {
+	c := &Client{retry: retryPolicy{baseDelay: time.Nanosecond}}
+	if delay := c.backoff(0); delay != time.Nanosecond {
+		t.Errorf("backoff(0) = %s, want 1ns", delay)
+	}
+}
+
+func TestBackoffSeededSequence(t *testing.T) {
+	c, err := New("http://localhost", "test-key", WithRetry(5, 100*time.Millisecond), WithRandSource(rand.NewSource(42)))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer c.Close()
+
+	want := []time.Duration{69692967, 156385107, 242967209, 511592555, 1238355226}
+	for attempt, delay := range want {
+		if got := c.backoff(attempt); got != delay {
+			t.Errorf("backoff(%d) = %d, want %d", attempt, got, delay)
+		}
+	}
+}
+
+func TestWithRandSourceRejectsNil(t *testing.T) {
+	if _, err := New("http://localhost", "test-key", WithRandSource(nil)); err == nil {
+		t.Error("New() error = nil, want an error for a nil source")
+	}
+}
+
+func TestRetryRetriesServerErrors(t *testing.T) {
+	var requests atomic.Int64
+	var delays []time.Duration
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) <= 2 {
+			writeJSON(w, http.StatusServiceUnavailable, `{"error":"busy"}`)
+			return
+		}
+		writeJSON(w, http.StatusOK, `{"status":"ok"}`)
+	}), WithRetry(3, time.Millisecond), WithRandSource(rand.NewSource(7)), WithOnRetry(func(attempt int, err error, nextDelay time.Duration) {
+		delays = append(delays, nextDelay)
+	}))
+
+	if _, err := c.GetHealth(); err != nil {
+		t.Fatalf("GetHealth() error = %v", err)
+	}
+	if got := requests.Load(); got != 3 {
+		t.Errorf("sent %d requests, want 3", got)
+	}
+
+	seeded := &Client{retry: c.retry, jitter: &jitterSource{random: rand.New(rand.NewSource(7))}}
+	want := []time.Duration{seeded.backoff(0), seeded.backoff(1)}
+	if len(delays) != len(want) || delays[0] != want[0] || delays[1] != want[1] {
+		t.Errorf("retry delays = %v, want %v", delays, want)
+	}
+}
+
+func TestRetryGivesUpAfterMaxRetries(t *testing.T) {
+	var requests atomic.Int64
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		writeJSON(w, http.StatusBadGateway, `{"error":"upstream"}`)
+	}), WithRetry(2, time.Millisecond))
+
+	_, err := c.GetHealth()
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadGateway {
+		t.Fatalf("GetHealth() error = %v, want a 502 *APIError", err)
+	}
+	if got := requests.Load(); got != 3 {
+		t.Errorf("sent %d requests, want 3", got)
+	}
+}
+
+func TestRetrySkipsClientErrors(t *testing.T) {
+	var requests atomic.Int64
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		writeJSON(w, http.StatusBadRequest, `{"error":"bad request"}`)
+	}), WithRetry(3, time.Millisecond))
+
+	if _, err := c.GetHealth(); err == nil {
+		t.Fatal("GetHealth() error = nil, want an error")
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("sent %d requests, want 1", got)
+	}
+}
+
+func TestRetryPostRequiresIdempotent(t *testing.T) {
+	for name, test := range map[string]struct {
+		opts []RequestOption
+		want int64
+	}{
+		"plain":      {want: 1},
+		"idempotent": {opts: []RequestOption{WithIdempotent()}, want: 2},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var requests atomic.Int64
+			var bodies []string
+			c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				bodies = append(bodies, string(body))
+				if requests.Add(1) == 1 {
+					writeJSON(w, http.StatusServiceUnavailable, `{"error":"busy"}`)
+					return
+				}
+				writeJSON(w, http.StatusCreated, `{}`)
+			}), WithRetry(3, time.Millisecond))
+
+			err := c.CreateArticle(testArticleRequest(), test.opts...)
+			if got := requests.Load(); got != test.want {
+				t.Fatalf("sent %d requests, want %d (error = %v)", got, test.want, err)
+			}
+			if test.want == 1 && err == nil {
+				t.Error("CreateArticle() error = nil, want the 503")
+			}
+			if test.want == 2 {
+				if err != nil {
+					t.Errorf("CreateArticle() error = %v", err)
+				}
+				if bodies[0] == "" || bodies[0] != bodies[1] {
+					t.Errorf("retried body = %q, want the original %q", bodies[1], bodies[0])
+				}
+			}
+		})
+	}
+}
+
+func TestMaxRetryDelayCapsEveryAttempt(t *testing.T) {
+	const limit = 2 * time.Second
+	c, err := New("http://localhost", "test-key", WithRetry(100, 50*time.Millisecond), WithMaxRetryDelay(limit))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer c.Close()
+
+	distinct := map[time.Duration]bool{}
+	for attempt := 0; attempt < 1000; attempt++ {
+		delay := c.backoff(attempt % 100)
+		if delay <= 0 || delay > limit {
+			t.Fatalf("backoff(%d) = %s, want a delay in (0, %s]", attempt%100, delay, limit)
+		}
+		if attempt%100 >= 10 {
+			distinct[delay] = true
+		}
+	}
+	if len(distinct) < 2 {
+		t.Errorf("capped delays are all %v, want jitter below the cap", distinct)
+	}
+}
+
+func TestMaxRetryDelayAppliesToRetries(t *testing.T) {
+	const limit = 5 * time.Millisecond
+	var delays []time.Duration
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusServiceUnavailable, `{"error":"busy"}`)
+	}), WithRetry(8, time.Millisecond), WithMaxRetryDelay(limit), WithOnRetry(func(attempt int, err error, nextDelay time.Duration) {
+		delays = append(delays, nextDelay)
+	}))
+
+	if _, err := c.GetHealth(); err == nil {
+		t.Fatal("GetHealth() error = nil, want an error")
+	}
+	if len(delays) != 8 {
+		t.Fatalf("got %d retries, want 8", len(delays))
+	}
+	for i, delay := range delays {
+		if delay > limit {
+			t.Errorf("retry %d delay = %s, want at most %s", i+1, delay, limit)
+		}
+	}
+}
+
+func TestWithMaxRetryDelayRejectsNonPositive(t *testing.T) {
+	if _, err := New("http://localhost", "test-key", WithMaxRetryDelay(0)); err == nil {
+		t.Error("New() error = nil, want an error for a zero delay")
+	}
+}
@@ -0,0 +1,119 @@
+// Package schema validates Data Warehouse article and podcast requests against embedded JSON schemas
+// before a client sends them. It lives in its own package so that only programs using it depend on the
+// JSON schema module.
+package schema
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"sync"
+
+	client "github.com/0ffsideCompass/data-warehouse-go-client"
+	"github.com/santhosh-tekuri/jsonschema/v6"
+)
+
+//go:embed schemas/*.json
+var schemaFiles embed.FS
+
+// resources lists the request types with an embedded schema, stored as schemas/<resource>.json.
+var resources = []string{"article", "podcast"}
+
+// compileRequestSchemas compiles the embedded request schemas once and shares them between clients.
+var compileRequestSchemas = sync.OnceValues(func() (map[string]*jsonschema.Schema, error) {
+	compiler := jsonschema.NewCompiler()
+	for _, resource := range resources {
+		name := resource + ".json"
+		data, err := schemaFiles.ReadFile("schemas/" + name)
+		if err != nil {
+			return nil, fmt.Errorf("error reading schema %s: %w", name, err)
+		}
+
+		doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("error parsing schema %s: %w", name, err)
+		}
+
+		if err := compiler.AddResource(name, doc); err != nil {
+			return nil, fmt.Errorf("error adding schema %s: %w", name, err)
+		}
+	}
+
+	schemas := make(map[string]*jsonschema.Schema, len(resources))
+	for _, resource := range resources {
+		schema, err := compiler.Compile(resource + ".json")
+		if err != nil {
+			return nil, fmt.Errorf("error compiling schema %s.json: %w", resource, err)
+		}
+		schemas[resource] = schema
+	}
+
+	return schemas, nil
+})
+
+// WithRequestSchemaValidation validates every article and podcast request against the JSON schemas
+// embedded in this package before it is sent. A request that does not match is rejected locally with
+// a *client.ValidationError describing each violation, which lets CI catch contract changes without a
+// live server. The check runs on the body the client actually sends, encoded with the marshaler set by
+// WithJSONMarshaler and with the fields renamed by WithFieldNameMapping, before WithRequestCompression
+// compresses it.
+//
+// Returns:
+//   - client.Option: Option enabling request schema validation
+func WithRequestSchemaValidation() client.Option {
+	return func(c *client.Client) error {
+		schemas, err := compileRequestSchemas()
+		if err != nil {
+			return err
+		}
+
+		return client.WithRequestBodyValidator(func(resource string, body []byte) error {
+			return validateRequestSchema(schemas, resource, body)
+		})(c)
+	}
+}
+
+// validateRequestSchema checks an encoded request body against the schema of resource, if there is one.
+//
+// Parameters:
+//   - schemas: The compiled schemas keyed by resource
+//   - resource: Name of the request type
+//   - body: The encoded request body that will be sent
+//
+// Returns:
+//   - error: A *client.ValidationError if the body does not match the schema, nil otherwise
+func validateRequestSchema(schemas map[string]*jsonschema.Schema, resource string, body []byte) error {
+	schema, ok := schemas[resource]
+	if !ok {
+		return nil
+	}
+
+	instance, err := jsonschema.UnmarshalJSON(bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("error parsing encoded request: %w", err)
+	}
+
+	err = schema.Validate(instance)
+	if err == nil {
+		return nil
+	}
+
+	var schemaErr *jsonschema.ValidationError
+	if !errors.As(err, &schemaErr) {
+		return err
+	}
+
+	validationErr := &client.ValidationError{Request: resource}
+	for _, unit := range schemaErr.BasicOutput().Errors {
+		if unit.Error == nil {
+			continue
+		}
+		validationErr.Violations = append(validationErr.Violations, client.Violation{
+			Field:   unit.InstanceLocation,
+			Message: unit.Error.String(),
+		})
+	}
+
+	return validationErr
+}
@@ -0,0 +1,95 @@
+package schema_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	client "github.com/0ffsideCompass/data-warehouse-go-client"
+	"github.com/0ffsideCompass/data-warehouse-go-client/schema"
+	"github.com/0ffsideCompass/models"
+)
+
+func TestWithRequestSchemaValidation(t *testing.T) {
+	var requests atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	c, err := client.New(server.URL, "test-key", schema.WithRequestSchemaValidation())
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer c.Close()
+
+	err = c.CreatePodcast(models.DataWarehouseCreatePodcastRequest{})
+	var validationErr *client.ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("CreatePodcast() error = %v, want *client.ValidationError", err)
+	}
+	if validationErr.Request != "podcast" {
+		t.Errorf("Request = %q, want %q", validationErr.Request, "podcast")
+	}
+	if got := requests.Load(); got != 0 {
+		t.Errorf("sent %d requests, want 0", got)
+	}
+
+	err = c.CreateArticle(models.DataWarehouseCreateArticleRequest{ExternalID: "1", Title: "Derby", URL: "https://example.com/derby", Tags: []string{"football"}})
+	if err != nil {
+		t.Errorf("CreateArticle() of a valid request error = %v", err)
+	}
+}
+
+func TestRequestSchemaValidationChecksEncodedBody(t *testing.T) {
+	var requests atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	c, err := client.New(server.URL, "test-key", schema.WithRequestSchemaValidation(),
+		client.WithFieldNameMapping(map[string]string{"url": "page_url"}))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer c.Close()
+
+	request := models.DataWarehouseCreateArticleRequest{ExternalID: "1", Title: "Derby", URL: "https://example.com/derby", Tags: []string{"football"}}
+	for name, call := range map[string]func() error{
+		"CreateArticle":       func() error { return c.CreateArticle(request) },
+		"UpdateArticle":       func() error { return c.UpdateArticle("a1", request) },
+		"BatchCreateArticles": func() error { return c.BatchCreateArticles([]models.DataWarehouseCreateArticleRequest{request}) },
+	} {
+		var validationErr *client.ValidationError
+		if err := call(); !errors.As(err, &validationErr) {
+			t.Errorf("%s() error = %v, want *client.ValidationError for the renamed url field", name, err)
+		}
+	}
+	if got := requests.Load(); got != 0 {
+		t.Errorf("sent %d requests, want 0", got)
+	}
+}
+
+func TestRequestSchemaValidationUsesConfiguredMarshaler(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	wire := []byte(`{"external_id":"1","title":"Derby","url":"https://example.com/derby","tags":["football"]}`)
+	c, err := client.New(server.URL, "test-key", schema.WithRequestSchemaValidation(),
+		client.WithJSONMarshaler(func(interface{}) ([]byte, error) { return wire, nil }))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer c.Close()
+
+	if err := c.CreateArticle(models.DataWarehouseCreateArticleRequest{ExternalID: "1", URL: "ftp://example.com/derby"}); err != nil {
+		t.Errorf("CreateArticle() error = %v, want the marshaler's body to be validated", err)
+	}
+}
@@ -0,0 +1,49 @@
+package client
+
+import (
+	"bytes"
+	"net/http"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// WithSingleFlight coalesces concurrent identical GET requests into a single request whose response is
+// shared, which protects hot resources from a thundering herd of readers. Requests are identical when
+// they have the same URL, including its query; requests with per-call headers, such as preconditions,
+// and requests with any other method are never coalesced. Each caller receives its own copy of the
+// response body and decodes it independently. The shared request runs with the context of the caller
+// that started it, so if that caller is cancelled, the callers waiting on it receive the same error.
+//
+// Returns:
+//   - Option: Option enabling request coalescing
+func WithSingleFlight() Option {
+	return func(c *Client) error {
+		c.singleFlight = &singleflight.Group{}
+		return nil
+	}
+}
+
+// coalesces reports whether req is eligible for coalescing under WithSingleFlight.
+func (c *Client) coalesces(req *http.Request, options *requestOptions) bool {
+	return c.singleFlight != nil && req.Method == http.MethodGet && len(options.header) == 0
+}
+
+// executeShared executes req, sharing the outcome with concurrent callers of the same URL.
+//
+// Parameters:
+//   - req: The prepared GET request
+//   - options: Per-call settings of the request
+//
+// Returns:
+//   - []byte: A copy of the response body owned by the caller
+//   - error: Error encountered during the request or response handling
+func (c *Client) executeShared(req *http.Request, options *requestOptions) ([]byte, error) {
+	body, err, _ := c.singleFlight.Do(req.URL.String(), func() (interface{}, error) {
+		return c.execute(req, options)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return bytes.Clone(body.([]byte)), nil
+}
@@ -0,0 +1,102 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// attemptKey is the context key holding the 1-based attempt number of a request.
+type attemptKey struct{}
+
+// withAttempt returns a copy of ctx recording the attempt number.
+func withAttempt(ctx context.Context, attempt int) context.Context {
+	return context.WithValue(ctx, attemptKey{}, attempt)
+}
+
+// attemptFromContext returns the attempt number recorded in ctx, or 1 if none is recorded.
+func attemptFromContext(ctx context.Context) int {
+	if attempt, ok := ctx.Value(attemptKey{}).(int); ok {
+		return attempt
+	}
+
+	return 1
+}
+
+// WithSlogLogger logs every request attempt through logger as a structured record with the attributes
+// method, endpoint, status, duration_ms and attempt. Successful attempts are logged at Info, failed
+// attempts and 5xx responses at Warn. Request and response bodies, masked by WithRedactedLogFields,
+// are only logged at Debug, so they appear only when the logger's handler enables that level.
+//
+// Parameters:
+//   - logger: Structured logger receiving one record per attempt
+//
+// Returns:
+//   - Option: Option registering the structured logging middleware
+func WithSlogLogger(logger *slog.Logger) Option {
+	return func(c *Client) error {
+		if logger == nil {
+			return errors.New("slog logger is nil")
+		}
+		c.middleware = append(c.middleware, slogMiddleware(logger, func() []string { return c.redactedLogFields }, c.logBodyLimits))
+		return nil
+	}
+}
+
+// slogMiddleware returns middleware emitting one structured record per attempt.
+func slogMiddleware(logger *slog.Logger, redactFields func() []string, limits func() (request, response int64)) Middleware {
+	return func(next RoundTrip) RoundTrip {
+		return func(req *http.Request) (*http.Response, error) {
+			ctx := req.Context()
+			debug := logger.Enabled(ctx, slog.LevelDebug)
+
+			requestLimit, responseLimit := limits()
+			var reqBody string
+			if debug {
+				reqBody = requestBodyForLog(req, redactFields(), requestLimit)
+			}
+
+			start := time.Now()
+			res, err := next(req)
+			attrs := []slog.Attr{
+				slog.String("method", req.Method),
+				slog.String("endpoint", req.URL.Path),
+				slog.Int64("duration_ms", time.Since(start).Milliseconds()),
+				slog.Int("attempt", attemptFromContext(ctx)),
+			}
+			if name := clientNameFromContext(ctx); name != "" {
+				attrs = append(attrs, slog.String("client", name))
+			}
+			if stats, ok := compressionFromContext(ctx); ok {
+				attrs = append(attrs,
+					slog.Int64("request_bytes", stats.raw),
+					slog.Int64("compressed_bytes", stats.compressed),
+					slog.Float64("compression_ratio", stats.ratio()),
+				)
+			}
+
+			if err != nil {
+				logger.LogAttrs(ctx, slog.LevelWarn, "data warehouse request failed", append(attrs, slog.String("error", err.Error()))...)
+				return res, err
+			}
+
+			attrs = append(attrs, slog.Int("status", res.StatusCode))
+			level := slog.LevelInfo
+			if res.StatusCode >= 500 {
+				level = slog.LevelWarn
+			}
+			logger.LogAttrs(ctx, level, "data warehouse request", attrs...)
+
+			if debug {
+				logger.LogAttrs(ctx, slog.LevelDebug, "data warehouse request bodies", append(attrs,
+					slog.String("request_body", reqBody),
+					slog.String("response_body", responseBodyForLog(ctx, res, redactFields(), responseLimit)),
+				)...)
+			}
+
+			return res, nil
+		}
+	}
+}
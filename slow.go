@@ -0,0 +1,49 @@
+package client
+
+import (
+	"errors"
+	"net/http"
+	"time"
+)
+
+// slowRequestWatcher reports request attempts whose duration exceeds a threshold.
+type slowRequestWatcher struct {
+	threshold time.Duration
+	onSlow    func(method, endpoint string, elapsed time.Duration)
+}
+
+// WithSlowRequestThreshold invokes onSlow for every request attempt that takes longer than threshold.
+// Only the time spent in the HTTP exchange itself is measured; JSON marshalling, middleware and
+// retry delays are excluded. This surfaces latency regressions against an SLO without full tracing.
+//
+// Parameters:
+//   - threshold: Duration above which an attempt is considered slow
+//   - onSlow: Callback receiving the method, endpoint path and elapsed time of each slow attempt
+//
+// Returns:
+//   - Option: Option enabling slow request reporting
+func WithSlowRequestThreshold(threshold time.Duration, onSlow func(method, endpoint string, elapsed time.Duration)) Option {
+	return func(c *Client) error {
+		if threshold <= 0 {
+			return errors.New("slow request threshold must be positive")
+		}
+		if onSlow == nil {
+			return errors.New("slow request callback is nil")
+		}
+		c.slowRequests = &slowRequestWatcher{threshold: threshold, onSlow: onSlow}
+		return nil
+	}
+}
+
+// wrap returns a RoundTrip timing each call to next.
+func (w *slowRequestWatcher) wrap(next RoundTrip) RoundTrip {
+	return func(req *http.Request) (*http.Response, error) {
+		start := time.Now()
+		res, err := next(req)
+		if elapsed := time.Since(start); elapsed > w.threshold {
+			w.onSlow(req.Method, req.URL.Path, elapsed)
+		}
+
+		return res, err
+	}
+}
@@ -0,0 +1,100 @@
+package client
+
+import (
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+// slowCall records one call of a WithSlowRequestThreshold callback.
+type slowCall struct {
+	method   string
+	endpoint string
+	elapsed  time.Duration
+}
+
+// slowRecorder collects the slow request callbacks of a client.
+type slowRecorder struct {
+	mu    sync.Mutex
+	calls []slowCall
+}
+
+func (r *slowRecorder) onSlow(method, endpoint string, elapsed time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, slowCall{method: method, endpoint: endpoint, elapsed: elapsed})
+}
+
+func (r *slowRecorder) recorded() []slowCall {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]slowCall(nil), r.calls...)
+}
+
+func TestSlowRequestThresholdReportsSlowAttempt(t *testing.T) {
+	const threshold = 20 * time.Millisecond
+	var recorder slowRecorder
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(2 * threshold)
+		writeJSON(w, http.StatusOK, `{"article":{"id":"a1"}}`)
+	}), WithSlowRequestThreshold(threshold, recorder.onSlow))
+
+	if _, err := c.GetArticle("a1"); err != nil {
+		t.Fatalf("GetArticle() error = %v", err)
+	}
+
+	calls := recorder.recorded()
+	if len(calls) != 1 {
+		t.Fatalf("onSlow called %d times, want 1", len(calls))
+	}
+	if calls[0].method != http.MethodGet || calls[0].endpoint != "/api/v1/articles/a1" {
+		t.Errorf("onSlow(%q, %q), want GET /api/v1/articles/a1", calls[0].method, calls[0].endpoint)
+	}
+	if calls[0].elapsed < threshold {
+		t.Errorf("elapsed = %v, want at least %v", calls[0].elapsed, threshold)
+	}
+}
+
+func TestSlowRequestThresholdIgnoresFastAttempt(t *testing.T) {
+	var recorder slowRecorder
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, `{"status":"ok"}`)
+	}), WithSlowRequestThreshold(time.Second, recorder.onSlow))
+
+	if _, err := c.GetHealth(); err != nil {
+		t.Fatalf("GetHealth() error = %v", err)
+	}
+	if calls := recorder.recorded(); len(calls) != 0 {
+		t.Errorf("onSlow called %d times for a fast request, want 0", len(calls))
+	}
+}
+
+func TestSlowRequestThresholdExcludesInterceptors(t *testing.T) {
+	const threshold = 20 * time.Millisecond
+	var recorder slowRecorder
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, `{"status":"ok"}`)
+	}), WithSlowRequestThreshold(threshold, recorder.onSlow), WithRequestInterceptor(func(req *http.Request) error {
+		time.Sleep(2 * threshold)
+		return nil
+	}))
+
+	if _, err := c.GetHealth(); err != nil {
+		t.Fatalf("GetHealth() error = %v", err)
+	}
+	if calls := recorder.recorded(); len(calls) != 0 {
+		t.Errorf("onSlow called %d times, want only the HTTP exchange to be timed", len(calls))
+	}
+}
+
+func TestWithSlowRequestThresholdRejectsInvalid(t *testing.T) {
+	for name, opt := range map[string]Option{
+		"zero threshold": WithSlowRequestThreshold(0, func(string, string, time.Duration) {}),
+		"nil callback":   WithSlowRequestThreshold(time.Second, nil),
+	} {
+		if _, err := New("http://localhost", "test-key", opt); err == nil {
+			t.Errorf("%s: New() error = nil, want an error", name)
+		}
+	}
+}
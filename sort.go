@@ -0,0 +1,41 @@
+package client
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// Sort orders accepted by the sorted list methods.
+const (
+	SortAsc  = "asc"
+	SortDesc = "desc"
+)
+
+// sortableFields lists the fields the sorted list methods may order by.
+var sortableFields = map[string]bool{
+	"id":         true,
+	"title":      true,
+	"created_at": true,
+	"updated_at": true,
+}
+
+// validateSort checks the sortBy and order arguments of the sorted list methods.
+func validateSort(sortBy, order string) error {
+	if !sortableFields[sortBy] {
+		return fmt.Errorf("unsupported sort field %q, expected one of id, title, created_at, updated_at", sortBy)
+	}
+	if order != SortAsc && order != SortDesc {
+		return fmt.Errorf("unsupported sort order %q, expected %q or %q", order, SortAsc, SortDesc)
+	}
+
+	return nil
+}
+
+// sortQuery returns endpoint with sort and order query parameters appended.
+func sortQuery(endpoint, sortBy, order string) string {
+	values := url.Values{}
+	values.Set("sort", sortBy)
+	values.Set("order", order)
+
+	return appendQuery(endpoint, values)
+}
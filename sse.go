@@ -0,0 +1,224 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/0ffsideCompass/models"
+)
+
+const (
+	articleStreamEndpoint = "/api/v1/articles/stream"
+
+	// sseMinReconnectDelay and sseMaxReconnectDelay bound the backoff between reconnection attempts.
+	sseMinReconnectDelay = time.Second
+	sseMaxReconnectDelay = 30 * time.Second
+)
+
+// ArticleEvent is a change notification received from the article change stream.
+type ArticleEvent struct {
+	// ID is the server-assigned event ID, used to resume the stream after a reconnect.
+	ID string
+	// Type is the SSE event name, for example "created", "updated" or "deleted".
+	Type string
+	// Article is the article carried by the event, or nil if the data is not an article.
+	Article *models.Article
+	// Data is the raw event data.
+	Data json.RawMessage
+}
+
+// sseEvent is a single server-sent event frame.
+type sseEvent struct {
+	id    string
+	event string
+	data  string
+	retry time.Duration
+}
+
+// SubscribeArticleChanges opens the article change stream and delivers each change as an ArticleEvent.
+// The initial connection is made before returning so configuration errors, such as an invalid API key,
+// are reported immediately. After that, transient disconnects are retried with exponential backoff,
+// resuming from the last received event via the Last-Event-ID header. The channel is closed when ctx
+// is cancelled or when the server rejects a reconnection with a non-retryable status.
+//
+// Parameters:
+//   - ctx: Context controlling the lifetime of the subscription
+//
+// Returns:
+//   - <-chan ArticleEvent: Channel receiving the change events
+//   - error: An error if the initial connection could not be established
+func (c *Client) SubscribeArticleChanges(ctx context.Context) (<-chan ArticleEvent, error) {
+	endpoint := c.endpoint(OperationSubscribeArticleChanges)
+	res, err := c.openStream(ctx, endpoint, "")
+	if err != nil {
+		return nil, fmt.Errorf("error subscribing to article changes: %w", err)
+	}
+
+	events := make(chan ArticleEvent)
+	go func() {
+		defer close(events)
+
+		var lastID string
+		base := sseMinReconnectDelay
+		delay := base
+		for {
+			received := readEvents(ctx, res.Body, func(e sseEvent) bool {
+				if e.id != "" {
+					lastID = e.id
+				}
+				if e.retry > 0 {
+					base = e.retry
+				}
+				if e.data == "" {
+					return true
+				}
+
+				event := ArticleEvent{ID: e.id, Type: e.event, Data: json.RawMessage(e.data)}
+				var article models.Article
+				if json.Unmarshal(event.Data, &article) == nil {
+					event.Article = &article
+				}
+
+				select {
+				case events <- event:
+					return true
+				case <-ctx.Done():
+					return false
+				}
+			})
+			res.Body.Close()
+			if received {
+				delay = base
+			}
+
+			for {
+				if sleep(ctx, delay) != nil {
+					return
+				}
+
+				res, err = c.openStream(ctx, endpoint, lastID)
+				if err == nil {
+					break
+				}
+				if !isTransientStreamError(err) {
+					return
+				}
+				delay = min(delay*2, sseMaxReconnectDelay)
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+// openStream connects to an SSE endpoint and returns the response once the server accepts the stream.
+// Streams bypass retries and timeouts because they are expected to stay open indefinitely.
+func (c *Client) openStream(ctx context.Context, endpoint, lastEventID string) (*http.Response, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, endpoint, nil, newRequestOptions(nil))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	req.Header.Set("Cache-Control", "no-cache")
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+
+	res, err := c.roundTrip(req)
+	if err != nil {
+		return nil, fmt.Errorf("error sending request: %w", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		defer res.Body.Close()
+		body, _ := io.ReadAll(io.LimitReader(res.Body, maxErrorBodySnippet))
+		return nil, c.errorFromResponse(res, body)
+	}
+
+	return res, nil
+}
+
+// isTransientStreamError reports whether reconnecting after err may succeed. Rejected credentials are
+// terminal even when a proxy answers with an HTML page, so a stream never reconnects forever with a bad
+// API key.
+func isTransientStreamError(err error) bool {
+	var authErr *AuthError
+	if errors.As(err, &authErr) {
+		return false
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return isRetryableStatus(apiErr.StatusCode)
+	}
+
+	var gatewayErr *GatewayError
+	if errors.As(err, &gatewayErr) {
+		return isRetryableStatus(gatewayErr.StatusCode)
+	}
+
+	return true
+}
+
+// readEvents parses SSE frames from r and passes each dispatched event to handle until the stream ends,
+// ctx is done, or handle returns false.
+//
+// Returns:
+//   - bool: True if at least one event was received
+func readEvents(ctx context.Context, r io.Reader, handle func(sseEvent) bool) bool {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var (
+		event    sseEvent
+		data     []string
+		received bool
+	)
+	for scanner.Scan() {
+		if ctx.Err() != nil {
+			return received
+		}
+
+		line := scanner.Text()
+		if line == "" {
+			event.data = strings.Join(data, "\n")
+			if event.data != "" || event.id != "" || event.retry > 0 {
+				received = true
+				if !handle(event) {
+					return received
+				}
+			}
+			event, data = sseEvent{}, nil
+			continue
+		}
+
+		if strings.HasPrefix(line, ":") {
+			continue
+		}
+
+		field, value, _ := strings.Cut(line, ":")
+		value = strings.TrimPrefix(value, " ")
+		switch field {
+		case "id":
+			event.id = value
+		case "event":
+			event.event = value
+		case "data":
+			data = append(data, value)
+		case "retry":
+			if ms, err := strconv.Atoi(value); err == nil && ms > 0 {
+				event.retry = time.Duration(ms) * time.Millisecond
+			}
+		}
+	}
+
+	return received
+}
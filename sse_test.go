@@ -0,0 +1,69 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestIsTransientStreamError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "network error", err: errors.New("connection reset"), want: true},
+		{name: "JSON 503", err: &APIError{StatusCode: http.StatusServiceUnavailable}, want: true},
+		{name: "JSON 404", err: &APIError{StatusCode: http.StatusNotFound}, want: false},
+		{name: "HTML 502", err: &GatewayError{StatusCode: http.StatusBadGateway, ContentType: "text/html"}, want: true},
+		{name: "HTML 404", err: &GatewayError{StatusCode: http.StatusNotFound, ContentType: "text/html"}, want: false},
+		{name: "JSON 401", err: &AuthError{StatusCode: http.StatusUnauthorized, Err: &APIError{StatusCode: http.StatusUnauthorized}}, want: false},
+		{name: "HTML 403", err: &AuthError{StatusCode: http.StatusForbidden, Err: &GatewayError{StatusCode: http.StatusForbidden, ContentType: "text/html"}}, want: false},
+	}
+
+	for _, tt := range tests {
+		err := fmt.Errorf("error subscribing to article changes: %w", tt.err)
+		if got := isTransientStreamError(err); got != tt.want {
+			t.Errorf("%s: isTransientStreamError(%v) = %v, want %v", tt.name, err, got, tt.want)
+		}
+	}
+}
+
+func TestSubscribeArticleChangesEndsOnHTMLAuthError(t *testing.T) {
+	var connections atomic.Int64
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if connections.Add(1) > 1 {
+			w.Header().Set("Content-Type", "text/html")
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte("<h1>401 Authorization Required</h1>"))
+			return
+		}
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Write([]byte("retry: 10\nid: 1\nevent: created\ndata: {\"id\":\"a1\"}\n\n"))
+	}))
+
+	events, err := c.SubscribeArticleChanges(context.Background())
+	if err != nil {
+		t.Fatalf("SubscribeArticleChanges() error = %v", err)
+	}
+
+	if event, ok := <-events; !ok || event.ID != "1" {
+		t.Fatalf("first event = %+v, %v, want event 1", event, ok)
+	}
+
+	select {
+	case _, ok := <-events:
+		if ok {
+			t.Fatal("received an unexpected second event")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("stream kept reconnecting after the credentials were rejected")
+	}
+	if got := connections.Load(); got != 2 {
+		t.Errorf("opened %d connections, want the rejected reconnect to be the last", got)
+	}
+}
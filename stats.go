@@ -0,0 +1,43 @@
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	articleStatsEndpoint = "/api/v1/articles/stats"
+)
+
+// ArticleStats holds aggregate statistics about the articles in the Data Warehouse.
+type ArticleStats struct {
+	Total           int        `json:"total"`
+	CreatedThisWeek int        `json:"created_this_week"`
+	TopTags         []TagCount `json:"top_tags"`
+}
+
+// GetArticleStats retrieves aggregate article statistics for reporting, such as the total number of
+// articles, how many were created this week and the most used tags. Older Data Warehouse deployments do
+// not provide the statistics endpoint; they answer with 404, which is returned as a *NotFoundError so
+// callers can detect the missing feature separately from transport errors.
+//
+// Parameters:
+//   - opts: Optional per-call settings such as WithContext
+//
+// Returns:
+//   - *ArticleStats: The article statistics
+//   - error: A *NotFoundError if the server has no statistics endpoint, otherwise an error reporting issues in sending the request, handling the response, or parsing the JSON
+func (c *Client) GetArticleStats(opts ...RequestOption) (*ArticleStats, error) {
+	endpoint := c.endpoint(OperationGetArticleStats)
+	body, err := c.get(context.Background(), endpoint, append(opts, operation(OperationGetArticleStats))...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting article stats: %w", asNotFound(err, "endpoint", endpoint))
+	}
+
+	stats, err := parse[ArticleStats](c, endpoint, body)
+	if err != nil {
+		return nil, fmt.Errorf("error getting article stats: %w", err)
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,75 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+const (
+	articleTagCountsEndpoint = "/api/v1/articles/tags/counts"
+)
+
+// TagCount pairs a tag with the number of articles carrying it.
+type TagCount struct {
+	Tag   string `json:"tag"`
+	Count int    `json:"count"`
+}
+
+// tagCountsResponse represents the API response of the article tag aggregation endpoint.
+type tagCountsResponse struct {
+	Counts map[string]int `json:"counts"`
+}
+
+// GetArticleTagCounts retrieves the number of articles per tag, for example to render a tag cloud.
+// The aggregation is performed by the Data Warehouse, so the response holds one entry per distinct tag
+// regardless of how many articles exist. Use SortTagCounts for a stable, ordered representation.
+//
+// Parameters:
+//   - opts: Optional per-call settings such as WithContext
+//
+// Returns:
+//   - map[string]int: Number of articles keyed by tag
+//   - error: An error object that reports issues either in sending the request, handling the response, or parsing the JSON
+func (c *Client) GetArticleTagCounts(opts ...RequestOption) (map[string]int, error) {
+	endpoint := c.endpoint(OperationGetArticleTagCounts)
+	body, err := c.get(context.Background(), endpoint, append(opts, operation(OperationGetArticleTagCounts))...)
+	if err != nil {
+		return nil, fmt.Errorf("error getting article tag counts: %w", err)
+	}
+
+	response, err := parse[tagCountsResponse](c, endpoint, body)
+	if err != nil {
+		return nil, fmt.Errorf("error getting article tag counts: %w", err)
+	}
+
+	if response.Counts == nil {
+		response.Counts = map[string]int{}
+	}
+
+	return response.Counts, nil
+}
+
+// SortTagCounts converts tag counts into a slice ordered by descending count, then ascending tag,
+// so the result is stable across calls.
+//
+// Parameters:
+//   - counts: Number of articles keyed by tag
+//
+// Returns:
+//   - []TagCount: The ordered tag counts
+func SortTagCounts(counts map[string]int) []TagCount {
+	sorted := make([]TagCount, 0, len(counts))
+	for tag, count := range counts {
+		sorted = append(sorted, TagCount{Tag: tag, Count: count})
+	}
+
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Count != sorted[j].Count {
+			return sorted[i].Count > sorted[j].Count
+		}
+		return sorted[i].Tag < sorted[j].Tag
+	})
+
+	return sorted
+}
@@ -0,0 +1,78 @@
+package client
+
+import "strings"
+
+// mergeTags returns a new slice holding tags followed by every entry of extra not already present.
+// The input slices are never modified, so callers' requests stay untouched.
+//
+// Parameters:
+//   - tags: Tags provided by the caller
+//   - extra: Tags to merge in
+//
+// Returns:
+//   - []string: The merged, de-duplicated tags
+func mergeTags(tags, extra []string) []string {
+	if len(extra) == 0 {
+		return tags
+	}
+
+	merged := make([]string, 0, len(tags)+len(extra))
+	seen := make(map[string]struct{}, len(tags)+len(extra))
+	for _, list := range [][]string{tags, extra} {
+		for _, tag := range list {
+			if _, ok := seen[tag]; ok {
+				continue
+			}
+			seen[tag] = struct{}{}
+			merged = append(merged, tag)
+		}
+	}
+
+	return merged
+}
+
+// NormalizeTag trims surrounding whitespace from tag and lowercases it.
+// It is the built-in normalizer intended for use with WithTagNormalizer.
+//
+// Parameters:
+//   - tag: The tag to normalize
+//
+// Returns:
+//   - string: The normalized tag
+func NormalizeTag(tag string) string {
+	return strings.ToLower(strings.TrimSpace(tag))
+}
+
+// prepareTags merges the client's default tags into tags and applies the tag normalizer, if any.
+func (c *Client) prepareTags(tags []string) []string {
+	tags = mergeTags(tags, c.defaultTags)
+	if c.tagNormalizer == nil {
+		return tags
+	}
+
+	return normalizeTags(tags, c.tagNormalizer)
+}
+
+// normalizeTags returns a new slice holding the normalized form of every tag, without duplicates or
+// empty tags, in first-seen order. The input slice is never modified.
+func normalizeTags(tags []string, normalize func(string) string) []string {
+	if tags == nil {
+		return nil
+	}
+
+	normalized := make([]string, 0, len(tags))
+	seen := make(map[string]struct{}, len(tags))
+	for _, tag := range tags {
+		tag = normalize(tag)
+		if tag == "" {
+			continue
+		}
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		normalized = append(normalized, tag)
+	}
+
+	return normalized
+}
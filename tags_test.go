@@ -0,0 +1,134 @@
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+// tagRecorder returns a handler answering creates with 201 and a pointer to the tags of the last
+// request body it received.
+func tagRecorder(t *testing.T) (http.Handler, *[]string) {
+	t.Helper()
+
+	var tags []string
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Tags []string `json:"tags"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("decoding request body: %v", err)
+		}
+		tags = body.Tags
+		writeJSON(w, http.StatusCreated, `{}`)
+	}), &tags
+}
+
+func TestDefaultTagsMergedWithoutMutatingCaller(t *testing.T) {
+	handler, sent := tagRecorder(t)
+	c := newTestClient(t, handler, WithDefaultTags("crawler-v2", "football"))
+
+	want := []string{"football", "derby", "crawler-v2"}
+	for name, create := range map[string]func(tags []string) error{
+		"CreateArticle": func(tags []string) error {
+			request := testArticleRequest()
+			request.Tags = tags
+			return c.CreateArticle(request)
+		},
+		"CreatePodcast": func(tags []string) error {
+			request := testPodcastRequest()
+			request.Tags = tags
+			return c.CreatePodcast(request)
+		},
+	} {
+		tags := make([]string, 2, 8)
+		copy(tags, []string{"football", "derby"})
+		spare := tags[:cap(tags)]
+
+		if err := create(tags); err != nil {
+			t.Fatalf("%s() error = %v", name, err)
+		}
+		if !reflect.DeepEqual(*sent, want) {
+			t.Errorf("%s: sent tags %q, want %q", name, *sent, want)
+		}
+		if !reflect.DeepEqual(tags, []string{"football", "derby"}) {
+			t.Errorf("%s: caller's tags = %q, want them unchanged", name, tags)
+		}
+		for i, tag := range spare[len(tags):] {
+			if tag != "" {
+				t.Errorf("%s: spare capacity of the caller's slice written at %d: %q", name, len(tags)+i, tag)
+			}
+		}
+	}
+}
+
+func TestWithDefaultTagsCopiesArguments(t *testing.T) {
+	handler, sent := tagRecorder(t)
+	defaults := []string{"crawler-v2"}
+	c := newTestClient(t, handler, WithDefaultTags(defaults...))
+	defaults[0] = "changed"
+
+	request := testArticleRequest()
+	request.Tags = nil
+	if err := c.CreateArticle(request); err != nil {
+		t.Fatalf("CreateArticle() error = %v", err)
+	}
+	if !reflect.DeepEqual(*sent, []string{"crawler-v2"}) {
+		t.Errorf("sent tags %q, want the default tags as configured", *sent)
+	}
+}
+
+func TestTagNormalizer(t *testing.T) {
+	tests := []struct {
+		name     string
+		defaults []string
+		tags     []string
+		want     []string
+	}{
+		{name: "normalizes", tags: []string{"Sports", "Derby Day "}, want: []string{"sports", "derby day"}},
+		{name: "dedupes after normalizing", tags: []string{"Sports", " sports", "SPORTS"}, want: []string{"sports"}},
+		{name: "drops empty results", tags: []string{"  ", "Sports", ""}, want: []string{"sports"}},
+		{name: "normalizes default tags", defaults: []string{" Crawler-V2", "sports"}, tags: []string{"Sports"}, want: []string{"sports", "crawler-v2"}},
+		{name: "only empty tags", tags: []string{"  "}, want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler, sent := tagRecorder(t)
+			c := newTestClient(t, handler, WithDefaultTags(tt.defaults...), WithTagNormalizer(NormalizeTag))
+
+			request := testArticleRequest()
+			request.Tags = append([]string(nil), tt.tags...)
+			if err := c.CreateArticle(request); err != nil {
+				t.Fatalf("CreateArticle() error = %v", err)
+			}
+			if len(*sent) != len(tt.want) || (len(tt.want) > 0 && !reflect.DeepEqual(*sent, tt.want)) {
+				t.Errorf("sent tags %q, want %q", *sent, tt.want)
+			}
+			if !reflect.DeepEqual(request.Tags, tt.tags) {
+				t.Errorf("caller's tags = %q, want them unchanged as %q", request.Tags, tt.tags)
+			}
+		})
+	}
+}
+
+func TestTagNormalizerAppliesToPodcasts(t *testing.T) {
+	handler, sent := tagRecorder(t)
+	c := newTestClient(t, handler, WithTagNormalizer(NormalizeTag))
+
+	request := testPodcastRequest()
+	request.Tags = []string{" Football", "football"}
+	if err := c.CreatePodcast(request); err != nil {
+		t.Fatalf("CreatePodcast() error = %v", err)
+	}
+	if !reflect.DeepEqual(*sent, []string{"football"}) {
+		t.Errorf("sent tags %q, want [football]", *sent)
+	}
+}
+
+func TestWithTagNormalizerRejectsNil(t *testing.T) {
+	if _, err := New("http://localhost", "test-key", WithTagNormalizer(nil)); err == nil {
+		t.Error("New() error = nil, want an error for a nil normalizer")
+	}
+}
@@ -0,0 +1,133 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// WithDefaultTimeout bounds every operation, including all retry attempts and the delays between them,
+// to d. It only applies when the call's context has no deadline of its own; a deadline set by the caller
+// through a context always takes precedence.
+//
+// Parameters:
+//   - d: Maximum duration of an operation
+//
+// Returns:
+//   - Option: Option setting the default timeout
+func WithDefaultTimeout(d time.Duration) Option {
+	return func(c *Client) error {
+		if d <= 0 {
+			return errors.New("default timeout must be positive")
+		}
+		c.defaultTimeout = d
+		return nil
+	}
+}
+
+// WithPerAttemptTimeout bounds each individual attempt, from sending the request to reading the response
+// body, to d. When WithRetry is enabled, an attempt that times out is retried like any other connection
+// error, until the retries are exhausted or the overall deadline (the caller's context or WithDefaultTimeout)
+// is reached. The effective limit of an attempt is therefore the earlier of d and the remaining overall time.
+//
+// Parameters:
+//   - d: Maximum duration of a single attempt
+//
+// Returns:
+//   - Option: Option setting the per-attempt timeout
+func WithPerAttemptTimeout(d time.Duration) Option {
+	return func(c *Client) error {
+		if d <= 0 {
+			return errors.New("per-attempt timeout must be positive")
+		}
+		c.perAttemptTimeout = d
+		return nil
+	}
+}
+
+// WithEndpointTimeout sets the timeout of individual operations, keyed by the Operation constants, for
+// example one second for OperationGetHealth and thirty seconds for OperationCreateArticle. For the listed
+// operations the timeout replaces WithDefaultTimeout; operations not in the map fall back to the default
+// timeout, or to no timeout if none is set. As with the default timeout, a deadline set by the caller
+// through a context always takes precedence.
+//
+// Parameters:
+//   - timeouts: Maximum duration, including retries, keyed by logical operation name
+//
+// Returns:
+//   - Option: Option setting the per-operation timeouts
+func WithEndpointTimeout(timeouts map[string]time.Duration) Option {
+	return func(c *Client) error {
+		for op, d := range timeouts {
+			if _, ok := defaultEndpoints[op]; !ok {
+				return fmt.Errorf("unknown operation %q", op)
+			}
+			if d <= 0 {
+				return fmt.Errorf("timeout for %q must be positive", op)
+			}
+		}
+
+		if c.endpointTimeouts == nil {
+			c.endpointTimeouts = make(map[string]time.Duration, len(timeouts))
+		}
+		for op, d := range timeouts {
+			c.endpointTimeouts[op] = d
+		}
+		return nil
+	}
+}
+
+// withDefaultTimeout applies the timeout of the operation op, or the default timeout, to req unless its
+// context already has a deadline. The returned cancel function must be called once the response has
+// been fully handled.
+func (c *Client) withDefaultTimeout(req *http.Request, op string) (*http.Request, context.CancelFunc) {
+	timeout, ok := c.endpointTimeouts[op]
+	if !ok {
+		timeout = c.defaultTimeout
+	}
+	if timeout <= 0 {
+		return req, func() {}
+	}
+	if _, ok := req.Context().Deadline(); ok {
+		return req, func() {}
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), timeout)
+	return req.WithContext(ctx), cancel
+}
+
+// attempt sends attempt number n of req, bounded by the per-attempt timeout when one is configured.
+// The attempt number is recorded in the request context for middleware, and the attempt's context is
+// released when the response body is closed.
+func (c *Client) attempt(req *http.Request, n int) (*http.Response, error) {
+	req = req.WithContext(withAttempt(req.Context(), n))
+	if c.perAttemptTimeout <= 0 {
+		return c.roundTrip(req)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), c.perAttemptTimeout)
+	res, err := c.roundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	res.Body = &cancelOnClose{ReadCloser: res.Body, cancel: cancel}
+	return res, nil
+}
+
+// cancelOnClose releases a context when the wrapped body is closed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+// Close closes the body and cancels the associated context.
+func (b *cancelOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
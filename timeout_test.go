@@ -0,0 +1,74 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// stallingHandler blocks the first stalls requests until the client gives up, then answers with a healthy status.
+func stallingHandler(requests *atomic.Int64, stalls int64) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) <= stalls {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(5 * time.Second):
+			}
+			return
+		}
+		writeJSON(w, http.StatusOK, `{"status":"ok"}`)
+	}
+}
+
+func TestPerAttemptTimeoutRetriesStalledAttempt(t *testing.T) {
+	var requests atomic.Int64
+	c := newTestClient(t, stallingHandler(&requests, 1), WithPerAttemptTimeout(50*time.Millisecond), WithRetry(2, time.Millisecond))
+
+	health, err := c.GetHealth()
+	if err != nil {
+		t.Fatalf("GetHealth() error = %v", err)
+	}
+	if health.Status != "ok" {
+		t.Errorf("Status = %q, want ok", health.Status)
+	}
+	if got := requests.Load(); got != 2 {
+		t.Errorf("sent %d requests, want 2", got)
+	}
+}
+
+func TestDefaultTimeoutBoundsAllAttempts(t *testing.T) {
+	var requests atomic.Int64
+	c := newTestClient(t, stallingHandler(&requests, 100),
+		WithPerAttemptTimeout(100*time.Millisecond), WithDefaultTimeout(250*time.Millisecond), WithRetry(10, time.Millisecond))
+
+	start := time.Now()
+	_, err := c.GetHealth()
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("GetHealth() error = %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("GetHealth() took %s, want the default timeout to stop the retries", elapsed)
+	}
+	if got := requests.Load(); got < 2 || got > 3 {
+		t.Errorf("sent %d requests, want the per-attempt timeout to allow 2 or 3 attempts", got)
+	}
+}
+
+func TestCallerDeadlineTakesPrecedence(t *testing.T) {
+	var requests atomic.Int64
+	c := newTestClient(t, stallingHandler(&requests, 1), WithDefaultTimeout(5*time.Second))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	if _, err := c.GetHealth(WithContext(ctx)); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("GetHealth() error = %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("GetHealth() took %s, want the caller's deadline to apply", elapsed)
+	}
+}
@@ -0,0 +1,113 @@
+package client
+
+import (
+	"crypto/tls"
+	"errors"
+	"net/http"
+	"net/http/httptrace"
+	"sync"
+	"time"
+)
+
+// RequestTrace holds the latency breakdown of a single request attempt.
+// Phases that did not happen, such as DNS, connect and TLS on a reused connection, are zero.
+type RequestTrace struct {
+	Method          string
+	Endpoint        string
+	ConnReused      bool
+	DNS             time.Duration
+	Connect         time.Duration
+	TLSHandshake    time.Duration
+	TimeToFirstByte time.Duration
+	Total           time.Duration
+}
+
+// tracer attaches an httptrace.ClientTrace to every request attempt.
+type tracer struct {
+	onTrace func(RequestTrace)
+}
+
+// WithHTTPTrace reports the DNS lookup, TCP connect, TLS handshake and time-to-first-byte durations of
+// every request attempt to onTrace, which pinpoints whether latency comes from name resolution,
+// connection setup or the server. Time to first byte is measured from the start of the attempt.
+// When the option is not set no trace is attached, so there is no overhead.
+//
+// Parameters:
+//   - onTrace: Callback receiving the trace of each attempt once its response headers have arrived or it failed
+//
+// Returns:
+//   - Option: Option enabling HTTP tracing
+func WithHTTPTrace(onTrace func(RequestTrace)) Option {
+	return func(c *Client) error {
+		if onTrace == nil {
+			return errors.New("trace callback is nil")
+		}
+		c.tracer = &tracer{onTrace: onTrace}
+		return nil
+	}
+}
+
+// wrap returns a RoundTrip tracing each call to next.
+func (t *tracer) wrap(next RoundTrip) RoundTrip {
+	return func(req *http.Request) (*http.Response, error) {
+		var (
+			mu                                      sync.Mutex
+			trace                                   = RequestTrace{Method: req.Method, Endpoint: req.URL.Path}
+			start                                   = time.Now()
+			dnsStart, connectStart, tlsStart, ready time.Time
+		)
+		clientTrace := &httptrace.ClientTrace{
+			DNSStart: func(httptrace.DNSStartInfo) {
+				mu.Lock()
+				dnsStart = time.Now()
+				mu.Unlock()
+			},
+			DNSDone: func(httptrace.DNSDoneInfo) {
+				mu.Lock()
+				trace.DNS = time.Since(dnsStart)
+				mu.Unlock()
+			},
+			ConnectStart: func(string, string) {
+				mu.Lock()
+				connectStart = time.Now()
+				mu.Unlock()
+			},
+			ConnectDone: func(string, string, error) {
+				mu.Lock()
+				trace.Connect = time.Since(connectStart)
+				mu.Unlock()
+			},
+			TLSHandshakeStart: func() {
+				mu.Lock()
+				tlsStart = time.Now()
+				mu.Unlock()
+			},
+			TLSHandshakeDone: func(tls.ConnectionState, error) {
+				mu.Lock()
+				trace.TLSHandshake = time.Since(tlsStart)
+				mu.Unlock()
+			},
+			GotConn: func(info httptrace.GotConnInfo) {
+				mu.Lock()
+				trace.ConnReused = info.Reused
+				mu.Unlock()
+			},
+			GotFirstResponseByte: func() {
+				mu.Lock()
+				ready = time.Now()
+				trace.TimeToFirstByte = ready.Sub(start)
+				mu.Unlock()
+			},
+		}
+
+		res, err := next(req.WithContext(httptrace.WithClientTrace(req.Context(), clientTrace)))
+
+		mu.Lock()
+		trace.Total = time.Since(start)
+		result := trace
+		mu.Unlock()
+		t.onTrace(result)
+
+		return res, err
+	}
+}
@@ -0,0 +1,83 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ResponseTransform rewrites the body of a successful response before it is decoded.
+type ResponseTransform func(body []byte) ([]byte, error)
+
+// WithResponseTransform rewrites the body of every successful response with transform before any
+// method decodes it, so the client can work behind proxies that change the response shape. It applies
+// uniformly to all methods that read a response body, including GetInto and PostInto; empty bodies are
+// passed through untouched, and error responses and the responses returned by GetArticleResponse and
+// GetPodcastResponse are never transformed. An error from transform fails the call. Multiple transforms run
+// in the order they are registered.
+//
+// Parameters:
+//   - transform: Function returning the rewritten body
+//
+// Returns:
+//   - Option: Option registering the response transform
+func WithResponseTransform(transform ResponseTransform) Option {
+	return func(c *Client) error {
+		if transform == nil {
+			return errors.New("response transform is nil")
+		}
+		c.responseTransforms = append(c.responseTransforms, transform)
+		return nil
+	}
+}
+
+// WithResponseEnvelopeField unwraps responses that a gateway wraps in an envelope object, such as
+// {"data": {...}}, by replacing every successful response body with the value of the named field. It is
+// a WithResponseTransform; a response that is not a JSON object or lacks the field fails the call with
+// a *DecodeError.
+//
+// Parameters:
+//   - field: Name of the envelope field holding the actual response
+//
+// Returns:
+//   - Option: Option unwrapping the envelope field
+func WithResponseEnvelopeField(field string) Option {
+	if field == "" {
+		return func(*Client) error { return errors.New("envelope field is empty") }
+	}
+
+	return WithResponseTransform(func(body []byte) ([]byte, error) {
+		var envelope map[string]json.RawMessage
+		if err := json.Unmarshal(body, &envelope); err != nil {
+			return nil, &DecodeError{Body: truncate(string(body), maxErrorBodySnippet), Err: fmt.Errorf("error unwrapping envelope: %w", err)}
+		}
+
+		value, ok := envelope[field]
+		if !ok {
+			return nil, &DecodeError{Body: truncate(string(body), maxErrorBodySnippet), Err: fmt.Errorf("response has no %q envelope field", field)}
+		}
+
+		return value, nil
+	})
+}
+
+// transformResponse applies the registered response transforms to a successful response body from
+// endpoint, recording the endpoint in any *DecodeError they return.
+func (c *Client) transformResponse(endpoint string, body []byte) ([]byte, error) {
+	if len(body) == 0 {
+		return body, nil
+	}
+
+	for _, transform := range c.responseTransforms {
+		var err error
+		if body, err = transform(body); err != nil {
+			var decodeErr *DecodeError
+			if errors.As(err, &decodeErr) && decodeErr.Endpoint == "" {
+				decodeErr.Endpoint = endpoint
+			}
+			return nil, fmt.Errorf("error transforming response: %w", err)
+		}
+	}
+
+	return body, nil
+}
@@ -0,0 +1,80 @@
+package client
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestResponseEnvelopeFieldUnwrapsArticle(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, `{"data":{"article":{"id":"a1","title":"Derby day"}},"meta":{"gateway":"legacy"}}`)
+	}), WithResponseEnvelopeField("data"))
+
+	article, err := c.GetArticle("a1")
+	if err != nil {
+		t.Fatalf("GetArticle() error = %v", err)
+	}
+	if article.ID != "a1" || article.Title != "Derby day" {
+		t.Errorf("article = %+v", article)
+	}
+}
+
+func TestResponseEnvelopeFieldMissing(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, `{"article":{"id":"a1"}}`)
+	}), WithResponseEnvelopeField("data"))
+
+	_, err := c.GetArticle("a1")
+	var decodeErr *DecodeError
+	if !errors.As(err, &decodeErr) {
+		t.Fatalf("GetArticle() error = %v, want *DecodeError", err)
+	}
+	if decodeErr.Endpoint != "/api/v1/articles/a1" {
+		t.Errorf("Endpoint = %q, want the request path", decodeErr.Endpoint)
+	}
+}
+
+func TestResponseTransformSkipsErrorResponses(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusNotFound, `{"error":"article not found"}`)
+	}), WithResponseEnvelopeField("data"))
+
+	var notFound *NotFoundError
+	if _, err := c.GetArticle("missing"); !errors.As(err, &notFound) {
+		t.Fatalf("GetArticle() error = %v, want *NotFoundError", err)
+	}
+}
+
+func TestResponseTransformsRunInOrder(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, `xx{"status":"ok"}`)
+	}), WithResponseTransform(func(body []byte) ([]byte, error) {
+		return bytes.TrimPrefix(body, []byte("x")), nil
+	}), WithResponseTransform(func(body []byte) ([]byte, error) {
+		if !bytes.HasPrefix(body, []byte(`x{`)) {
+			t.Errorf("second transform got %q, want the first transform's output", body)
+		}
+		return bytes.TrimPrefix(body, []byte("x")), nil
+	}))
+
+	health, err := c.GetHealth()
+	if err != nil {
+		t.Fatalf("GetHealth() error = %v", err)
+	}
+	if health.Status != "ok" {
+		t.Errorf("Status = %q, want ok", health.Status)
+	}
+}
+
+func TestResponseTransformOptionsRejectInvalid(t *testing.T) {
+	for name, opt := range map[string]Option{
+		"nil transform":  WithResponseTransform(nil),
+		"empty envelope": WithResponseEnvelopeField(""),
+	} {
+		if _, err := New("http://localhost", "test-key", opt); err == nil {
+			t.Errorf("%s: New() error = nil, want an error", name)
+		}
+	}
+}
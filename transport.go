@@ -0,0 +1,139 @@
+package client
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"time"
+)
+
+const (
+	// defaultKeepAlive is the TCP keep-alive period of connections, matching http.DefaultTransport.
+	defaultKeepAlive = 30 * time.Second
+)
+
+// WithIdleConnTimeout sets how long an idle keep-alive connection stays in the pool before the client
+// closes it. Choose a value below the server's or load balancer's own idle timeout: otherwise the
+// server may close a pooled connection first and the next request on it fails with a connection reset.
+// Shorter values avoid such resets at the cost of more handshakes after quiet periods.
+//
+// Parameters:
+//   - d: Idle timeout; zero means no limit
+//
+// Returns:
+//   - Option: Option setting the idle connection timeout
+func WithIdleConnTimeout(d time.Duration) Option {
+	return func(c *Client) error {
+		if d < 0 {
+			return errors.New("idle connection timeout must not be negative")
+		}
+		c.ownTransport().IdleConnTimeout = d
+		return nil
+	}
+}
+
+// WithDisableKeepAlives controls connection reuse. Disabling keep-alives opens a new connection for
+// every request, which rules out resets on reaped idle connections but pays a TCP and TLS handshake
+// each time and defeats Warmup; it is mainly useful against servers that handle pooled connections badly.
+//
+// Parameters:
+//   - disable: True to use a new connection for every request
+//
+// Returns:
+//   - Option: Option setting whether keep-alives are disabled
+func WithDisableKeepAlives(disable bool) Option {
+	return func(c *Client) error {
+		c.ownTransport().DisableKeepAlives = disable
+		return nil
+	}
+}
+
+// WithDialTimeout bounds how long establishing a TCP connection may take, including DNS resolution, so
+// requests fail fast when the Data Warehouse is unreachable. It only applies when a new connection is
+// opened; requests on pooled connections are unaffected. The default is 30 seconds.
+//
+// Parameters:
+//   - d: Maximum time to resolve and connect; must be positive
+//
+// Returns:
+//   - Option: Option setting the dial timeout
+func WithDialTimeout(d time.Duration) Option {
+	return func(c *Client) error {
+		if d <= 0 {
+			return errors.New("dial timeout must be positive")
+		}
+		dialer := &net.Dialer{Timeout: d, KeepAlive: defaultKeepAlive}
+		c.ownTransport().DialContext = dialer.DialContext
+		return nil
+	}
+}
+
+// WithTLSHandshakeTimeout bounds how long the TLS handshake of a new connection may take, after the
+// TCP connection is established. The default is 10 seconds.
+//
+// Parameters:
+//   - d: Maximum time for the TLS handshake; zero means no limit
+//
+// Returns:
+//   - Option: Option setting the TLS handshake timeout
+func WithTLSHandshakeTimeout(d time.Duration) Option {
+	return func(c *Client) error {
+		if d < 0 {
+			return errors.New("tls handshake timeout must not be negative")
+		}
+		c.ownTransport().TLSHandshakeTimeout = d
+		return nil
+	}
+}
+
+// WithResponseHeaderTimeout bounds how long to wait for the response headers once the request,
+// including its body, has been written. It detects a server that accepts the request but does not
+// answer, while reading a slow response body remains bounded only by the request's context and
+// WithDefaultTimeout. There is no limit by default.
+//
+// Parameters:
+//   - d: Maximum time to wait for response headers; zero means no limit
+//
+// Returns:
+//   - Option: Option setting the response header timeout
+func WithResponseHeaderTimeout(d time.Duration) Option {
+	return func(c *Client) error {
+		if d < 0 {
+			return errors.New("response header timeout must not be negative")
+		}
+		c.ownTransport().ResponseHeaderTimeout = d
+		return nil
+	}
+}
+
+// buildTransport returns the transport of the underlying HTTP client: the client's own transport if one
+// was configured, otherwise http.DefaultTransport, wrapped for replay or recording.
+func (c *Client) buildTransport() (http.RoundTripper, error) {
+	if c.replayDir != "" && c.recordDir != "" {
+		return nil, errors.New("replay and record modes are mutually exclusive")
+	}
+
+	var transport http.RoundTripper = http.DefaultTransport
+	if c.transport != nil {
+		transport = c.transport
+	}
+
+	switch {
+	case c.replayDir != "":
+		return &replayTransport{dir: c.replayDir}, nil
+	case c.recordDir != "":
+		return &recordTransport{dir: c.recordDir, next: transport}, nil
+	default:
+		return transport, nil
+	}
+}
+
+// ownTransport returns the transport owned by the client, creating it on first use as a clone of
+// http.DefaultTransport so that tuning one client never affects others.
+func (c *Client) ownTransport() *http.Transport {
+	if c.transport == nil {
+		c.transport = http.DefaultTransport.(*http.Transport).Clone()
+	}
+
+	return c.transport
+}
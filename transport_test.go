@@ -0,0 +1,132 @@
+package client
+
+import (
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// httpTransport returns the *http.Transport the client sends requests through.
+func httpTransport(t *testing.T, c *Client) *http.Transport {
+	t.Helper()
+
+	transport, ok := c.client.Transport.(*http.Transport)
+	if !ok {
+		t.Fatalf("Transport = %T, want *http.Transport", c.client.Transport)
+	}
+	return transport
+}
+
+func TestConnectionReuseOptions(t *testing.T) {
+	var closes []bool
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		closes = append(closes, r.Close)
+		writeJSON(w, http.StatusOK, `{"status":"ok"}`)
+	}), WithIdleConnTimeout(15*time.Second), WithDisableKeepAlives(true))
+
+	transport := httpTransport(t, c)
+	if transport.IdleConnTimeout != 15*time.Second {
+		t.Errorf("IdleConnTimeout = %s, want 15s", transport.IdleConnTimeout)
+	}
+	if !transport.DisableKeepAlives {
+		t.Error("DisableKeepAlives = false, want true")
+	}
+	if transport == http.DefaultTransport {
+		t.Error("options modified http.DefaultTransport")
+	}
+	if http.DefaultTransport.(*http.Transport).DisableKeepAlives {
+		t.Error("http.DefaultTransport has keep-alives disabled")
+	}
+
+	if _, err := c.GetHealth(); err != nil {
+		t.Fatalf("GetHealth() error = %v", err)
+	}
+	if len(closes) != 1 || !closes[0] {
+		t.Errorf("request Close = %v, want the connection closed after the request", closes)
+	}
+}
+
+func TestDefaultTransportIsShared(t *testing.T) {
+	c, err := New("http://localhost", "test-key")
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer c.Close()
+
+	if c.client.Transport != http.DefaultTransport {
+		t.Errorf("Transport = %v, want http.DefaultTransport", c.client.Transport)
+	}
+}
+
+func TestWithIdleConnTimeoutRejectsNegative(t *testing.T) {
+	if _, err := New("http://localhost", "test-key", WithIdleConnTimeout(-time.Second)); err == nil {
+		t.Error("New() error = nil, want an error for a negative timeout")
+	}
+}
+
+func TestTransportTimeoutOptions(t *testing.T) {
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, `{"status":"ok"}`)
+	}), WithDialTimeout(2*time.Second), WithTLSHandshakeTimeout(3*time.Second), WithResponseHeaderTimeout(4*time.Second))
+
+	transport := httpTransport(t, c)
+	if transport.DialContext == nil {
+		t.Error("DialContext = nil, want the configured dialer")
+	}
+	if transport.TLSHandshakeTimeout != 3*time.Second {
+		t.Errorf("TLSHandshakeTimeout = %s, want 3s", transport.TLSHandshakeTimeout)
+	}
+	if transport.ResponseHeaderTimeout != 4*time.Second {
+		t.Errorf("ResponseHeaderTimeout = %s, want 4s", transport.ResponseHeaderTimeout)
+	}
+	if transport.IdleConnTimeout != http.DefaultTransport.(*http.Transport).IdleConnTimeout {
+		t.Errorf("IdleConnTimeout = %s, want the default transport's value", transport.IdleConnTimeout)
+	}
+
+	if _, err := c.GetHealth(); err != nil {
+		t.Fatalf("GetHealth() through the configured dialer error = %v", err)
+	}
+}
+
+func TestResponseHeaderTimeoutAllowsSlowBody(t *testing.T) {
+	var requests atomic.Int64
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(5 * time.Second):
+			}
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.(http.Flusher).Flush()
+		time.Sleep(100 * time.Millisecond)
+		w.Write([]byte(`{"status":"ok"}`))
+	}), WithResponseHeaderTimeout(50*time.Millisecond))
+
+	start := time.Now()
+	if _, err := c.GetHealth(); err == nil {
+		t.Fatal("GetHealth() with stalled headers error = nil, want a timeout")
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("GetHealth() took %s, want the header timeout to fail fast", elapsed)
+	}
+
+	if _, err := c.GetHealth(); err != nil {
+		t.Errorf("GetHealth() with a slow body error = %v, want the header timeout not to cover the body", err)
+	}
+}
+
+func TestTransportTimeoutOptionsRejectInvalid(t *testing.T) {
+	for name, opt := range map[string]Option{
+		"dial":            WithDialTimeout(0),
+		"tls handshake":   WithTLSHandshakeTimeout(-time.Second),
+		"response header": WithResponseHeaderTimeout(-time.Second),
+	} {
+		if _, err := New("http://localhost", "test-key", opt); err == nil {
+			t.Errorf("%s: New() error = nil, want an error", name)
+		}
+	}
+}
@@ -0,0 +1,212 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/0ffsideCompass/models"
+)
+
+const (
+	// articleRequest and podcastRequest are the resource names passed to a RequestValidator and a
+	// RequestBodyValidator.
+	articleRequest = "article"
+	podcastRequest = "podcast"
+)
+
+// RequestValidator checks an article or podcast request before it is sent. resource is "article" or
+// "podcast" and request the models.DataWarehouseCreateArticleRequest or
+// models.DataWarehouseCreatePodcastRequest after default tags and tag normalization were applied.
+// The validate and schema subpackages provide validators built on go-playground/validator and on
+// JSON schemas, so the root package does not depend on either.
+type RequestValidator func(resource string, request interface{}) error
+
+// WithRequestValidator registers a hook that checks every article and podcast request before it is
+// sent, so invalid requests are rejected locally. Validators run in the order they are registered and
+// the first error, typically a *ValidationError, fails the call without sending the request.
+//
+// Parameters:
+//   - validator: Function called with each request
+//
+// Returns:
+//   - Option: Option adding the request validator
+func WithRequestValidator(validator RequestValidator) Option {
+	return func(c *Client) error {
+		if validator == nil {
+			return errors.New("request validator is nil")
+		}
+		c.requestValidators = append(c.requestValidators, validator)
+		return nil
+	}
+}
+
+// validateRequest runs the registered request validators on request.
+//
+// Parameters:
+//   - resource: Name of the request type, "article" or "podcast"
+//   - request: The request that will be sent
+//
+// Returns:
+//   - error: The error of the first failing validator, nil otherwise
+func (c *Client) validateRequest(resource string, request interface{}) error {
+	for _, validator := range c.requestValidators {
+		if err := validator(resource, request); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RequestBodyValidator checks the encoded JSON body of an article or podcast request before it is sent.
+// resource is "article" or "podcast" and body the bytes produced by the configured marshaler, after
+// WithFieldNameMapping renamed the fields and before WithRequestCompression compresses them, so it sees
+// exactly the payload the server receives. For BatchCreateArticles it is called once per article, with
+// that article's encoding. The body must not be modified or retained.
+type RequestBodyValidator func(resource string, body []byte) error
+
+// WithRequestBodyValidator registers a hook that checks the encoded body of every article and podcast
+// request before it is sent, for contract checks such as schema.WithRequestSchemaValidation that must
+// match the wire format. Body validators run after the validators registered with WithRequestValidator,
+// in the order they are registered, and the first error fails the call without sending the request.
+//
+// Parameters:
+//   - validator: Function called with each encoded request body
+//
+// Returns:
+//   - Option: Option adding the request body validator
+func WithRequestBodyValidator(validator RequestBodyValidator) Option {
+	return func(c *Client) error {
+		if validator == nil {
+			return errors.New("request body validator is nil")
+		}
+		c.bodyValidators = append(c.bodyValidators, validator)
+		return nil
+	}
+}
+
+// validatedBody marks a call whose body is a request of the given resource, so encodeBody runs the
+// request body validators on it.
+func validatedBody(resource string) RequestOption {
+	return func(o *requestOptions) {
+		o.bodyOf = resource
+	}
+}
+
+// validateRequestBody runs the registered request body validators on body.
+//
+// Parameters:
+//   - resource: Name of the request type, "article" or "podcast"
+//   - body: The encoded request body that will be sent
+//
+// Returns:
+//   - error: The error of the first failing validator, nil otherwise
+func (c *Client) validateRequestBody(resource string, body []byte) error {
+	for _, validator := range c.bodyValidators {
+		if err := validator(resource, body); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ResponseValidator checks an entity decoded from a Data Warehouse response.
+// It is called with *models.Article, *models.Podcast, *HealthResponse or, for GetInto and PostInto,
+// the caller-supplied target.
+type ResponseValidator func(entity interface{}) error
+
+// WithResponseValidator registers a hook that asserts invariants on every decoded entity, for example
+// that every article has a non-empty ID and a well-formed URL. List responses are validated one entity at
+// a time. If the validator returns an error, the method returns it wrapped in a *ResponseValidationError,
+// turning schema drift into loud failures in contract tests. No validation is performed by default.
+//
+// Parameters:
+//   - validator: Function called with each decoded entity
+//
+// Returns:
+//   - Option: Option setting the response validator
+func WithResponseValidator(validator ResponseValidator) Option {
+	return func(c *Client) error {
+		if validator == nil {
+			return errors.New("response validator is nil")
+		}
+		c.responseValidator = validator
+		return nil
+	}
+}
+
+// ResponseValidationError reports that a decoded entity was rejected by the validator set with
+// WithResponseValidator.
+type ResponseValidationError struct {
+	Endpoint string
+	Err      error
+}
+
+// Error returns the endpoint and the validator's error.
+func (e *ResponseValidationError) Error() string {
+	return fmt.Sprintf("response from %s failed validation: %v", e.Endpoint, e.Err)
+}
+
+// Unwrap returns the validator's error.
+func (e *ResponseValidationError) Unwrap() error {
+	return e.Err
+}
+
+// validateResponse runs the response validator, if any, on every entity carried by value.
+func (c *Client) validateResponse(endpoint string, value interface{}) error {
+	if c.responseValidator == nil {
+		return nil
+	}
+
+	for _, entity := range responseEntities(value) {
+		if err := c.responseValidator(entity); err != nil {
+			return &ResponseValidationError{Endpoint: endpoint, Err: err}
+		}
+	}
+
+	return nil
+}
+
+// responseEntities unpacks the response envelopes of this package into the entities they carry.
+// Values of any other type are returned as a single entity.
+func responseEntities(value interface{}) []interface{} {
+	var entities []interface{}
+
+	switch v := value.(type) {
+	case *ArticleResponse:
+		if v.Article != nil {
+			entities = append(entities, v.Article)
+		}
+	case *PodcastResponse:
+		if v.Podcast != nil {
+			entities = append(entities, v.Podcast)
+		}
+	case *PaginatedArticlesResponse:
+		for i := range v.Articles {
+			entities = append(entities, &v.Articles[i])
+		}
+	case *PaginatedPodcastsResponse:
+		for i := range v.Podcasts {
+			entities = append(entities, &v.Podcasts[i])
+		}
+	case *CursorArticlesResponse:
+		for i := range v.Articles {
+			entities = append(entities, &v.Articles[i])
+		}
+	case *models.DataWarehouseArticlesResponse:
+		for i := range v.Articles {
+			entities = append(entities, &v.Articles[i])
+		}
+	case *models.DataWarehousePodcastsResponse:
+		for i := range v.Podcasts {
+			entities = append(entities, &v.Podcasts[i])
+		}
+	case *tagCountsResponse, *ArticleStats:
+		// Tag counts and statistics are aggregates rather than entities.
+	default:
+		entities = append(entities, value)
+	}
+
+	return entities
+}
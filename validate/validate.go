@@ -0,0 +1,117 @@
+// Package validate validates Data Warehouse article and podcast requests with go-playground/validator
+// before a client sends them. It lives in its own package so that only programs using it depend on the
+// validator module.
+package validate
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	client "github.com/0ffsideCompass/data-warehouse-go-client"
+	"github.com/0ffsideCompass/models"
+	"github.com/go-playground/validator/v10"
+)
+
+// articleRequestRules and podcastRequestRules are the go-playground/validator rules applied by
+// WithStructValidation, keyed by struct field. They are registered as map rules because the request
+// models live in another module and carry no validate tags.
+var (
+	articleRequestRules = map[string]string{
+		"Title": "required",
+		"URL":   "required,url",
+		"Tags":  "dive,required",
+	}
+	podcastRequestRules = map[string]string{
+		"Title": "required",
+		"URL":   "required,url",
+		"Tags":  "dive,required",
+	}
+)
+
+// WithStructValidation validates article and podcast requests with go-playground/validator before
+// they are sent: the title is required, the URL must be an absolute URL and tags must not be empty.
+// Failures are reported as a *client.ValidationError listing every violation by JSON field name.
+// Additional rules, for example for ExternalID, can be passed as validator tags keyed by struct field
+// and are applied to both request types.
+//
+// Parameters:
+//   - extraRules: Optional validator tags keyed by request struct field, merged over the defaults
+//
+// Returns:
+//   - client.Option: Option enabling struct validation
+func WithStructValidation(extraRules ...map[string]string) client.Option {
+	articleRules := cloneRules(articleRequestRules)
+	podcastRules := cloneRules(podcastRequestRules)
+	for _, rules := range extraRules {
+		for field, tag := range rules {
+			articleRules[field] = tag
+			podcastRules[field] = tag
+		}
+	}
+
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	validate.RegisterTagNameFunc(jsonFieldName)
+	validate.RegisterStructValidationMapRules(articleRules, models.DataWarehouseCreateArticleRequest{})
+	validate.RegisterStructValidationMapRules(podcastRules, models.DataWarehouseCreatePodcastRequest{})
+
+	return client.WithRequestValidator(func(resource string, request interface{}) error {
+		return validateStruct(validate, resource, request)
+	})
+}
+
+// validateStruct checks request with validate.
+//
+// Parameters:
+//   - validate: The configured validator
+//   - resource: Name of the request type, used in the error
+//   - request: The request that will be sent
+//
+// Returns:
+//   - error: A *client.ValidationError if the request violates a rule, nil otherwise
+func validateStruct(validate *validator.Validate, resource string, request interface{}) error {
+	err := validate.Struct(request)
+	if err == nil {
+		return nil
+	}
+
+	var fieldErrs validator.ValidationErrors
+	if !errors.As(err, &fieldErrs) {
+		return err
+	}
+
+	validationErr := &client.ValidationError{Request: resource}
+	for _, fieldErr := range fieldErrs {
+		message := fmt.Sprintf("failed %q validation", fieldErr.Tag())
+		if fieldErr.Param() != "" {
+			message = fmt.Sprintf("failed %q validation with %q", fieldErr.Tag(), fieldErr.Param())
+		}
+		validationErr.Violations = append(validationErr.Violations, client.Violation{
+			Field:   fieldErr.Field(),
+			Message: message,
+		})
+	}
+
+	return validationErr
+}
+
+// jsonFieldName returns the JSON name of a struct field so violations use the names sent on the wire.
+func jsonFieldName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "-" || name == "" {
+		return field.Name
+	}
+
+	return name
+}
+
+// cloneRules returns a copy of rules.
+func cloneRules(rules map[string]string) map[string]string {
+	clone := make(map[string]string, len(rules))
+	for field, tag := range rules {
+		clone[field] = tag
+	}
+
+	return clone
+}
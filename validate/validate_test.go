@@ -0,0 +1,51 @@
+package validate_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	client "github.com/0ffsideCompass/data-warehouse-go-client"
+	"github.com/0ffsideCompass/data-warehouse-go-client/validate"
+	"github.com/0ffsideCompass/models"
+)
+
+func TestWithStructValidationRejectsInvalidRequest(t *testing.T) {
+	var requests atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	c, err := client.New(server.URL, "test-key", validate.WithStructValidation(map[string]string{"ExternalID": "required"}))
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	defer c.Close()
+
+	err = c.CreateArticle(models.DataWarehouseCreateArticleRequest{Title: "Derby", URL: "not a url", Tags: []string{""}})
+	var validationErr *client.ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("CreateArticle() error = %v, want *client.ValidationError", err)
+	}
+	fields := map[string]bool{}
+	for _, violation := range validationErr.Violations {
+		fields[violation.Field] = true
+	}
+	for _, field := range []string{"url", "external_id", "tags[0]"} {
+		if !fields[field] {
+			t.Errorf("no violation for %s in %v", field, validationErr.Violations)
+		}
+	}
+	if got := requests.Load(); got != 0 {
+		t.Errorf("sent %d requests, want 0", got)
+	}
+
+	err = c.CreateArticle(models.DataWarehouseCreateArticleRequest{ExternalID: "1", Title: "Derby", URL: "https://example.com/derby"})
+	if err != nil {
+		t.Errorf("CreateArticle() of a valid request error = %v", err)
+	}
+}
@@ -0,0 +1,73 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// Warmup opens up to n connections to the Data Warehouse ahead of time by sending n concurrent HEAD
+// requests to the health endpoint, so that later requests reuse connections whose TCP and TLS
+// handshakes are already done. Any response status counts as success, since only the connection
+// matters. Warmup is best-effort: connections beyond the transport's idle limit per host (two for
+// http.DefaultTransport) are closed once the requests finish, the server may close idle connections
+// at any time, and requests that overlap less than expected may share a connection. Warm-up requests
+// bypass retries, middleware and WithMaxConcurrentRequests.
+//
+// Parameters:
+//   - ctx: Context controlling cancellation of the warm-up requests
+//   - n: Number of connections to open
+//
+// Returns:
+//   - error: An error if n is less than 1, or the errors of the warm-up requests that failed
+func (c *Client) Warmup(ctx context.Context, n int) error {
+	if n < 1 {
+		return errors.New("n must be at least 1")
+	}
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if err := c.warmConnection(ctx); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return fmt.Errorf("error warming up %d of %d connections: %w", len(errs), n, errors.Join(errs...))
+	}
+
+	return nil
+}
+
+// warmConnection sends a single HEAD request to the health endpoint and drains the response so its
+// connection is returned to the idle pool.
+func (c *Client) warmConnection(ctx context.Context) error {
+	req, err := c.newRequest(ctx, http.MethodHead, c.endpoint(OperationGetHealth), nil, newRequestOptions(nil))
+	if err != nil {
+		return err
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	io.Copy(io.Discard, res.Body)
+	res.Body.Close()
+
+	return nil
+}
@@ -0,0 +1,237 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	eventsEndpoint = "/api/v1/events"
+
+	// wsPongWait is how long the connection may stay silent before it is considered dead.
+	wsPongWait = 60 * time.Second
+	// wsPingPeriod is how often pings are sent; it must be shorter than wsPongWait.
+	wsPingPeriod = wsPongWait * 9 / 10
+	// wsWriteWait bounds the time allowed to write a control frame.
+	wsWriteWait = 10 * time.Second
+)
+
+// LiveEvent is an article or podcast change received over the WebSocket event endpoint.
+type LiveEvent struct {
+	Type     string          `json:"type"`
+	Resource string          `json:"resource"`
+	ID       string          `json:"id"`
+	Data     json.RawMessage `json:"data"`
+}
+
+// EventSubscription is a live connection to the WebSocket event endpoint.
+// Events are delivered on the channel returned by Events until Close is called or the context passed
+// to ConnectEvents is cancelled.
+type EventSubscription struct {
+	client *Client
+	events chan LiveEvent
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	mu   sync.Mutex
+	conn *websocket.Conn
+}
+
+// ConnectEvents dials the WebSocket event endpoint and streams live article and podcast events.
+// The connection is kept alive with ping/pong frames, and when it drops it is re-established with
+// exponential backoff. The initial dial happens before returning so authentication failures are
+// reported immediately. This is an alternative to SubscribeArticleChanges for servers that expose
+// WebSocket rather than server-sent events.
+//
+// Parameters:
+//   - ctx: Context controlling the lifetime of the subscription
+//
+// Returns:
+//   - *EventSubscription: The live subscription; call Close when done
+//   - error: An error if the initial connection could not be established
+func (c *Client) ConnectEvents(ctx context.Context) (*EventSubscription, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	sub := &EventSubscription{
+		client: c,
+		events: make(chan LiveEvent),
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+
+	conn, err := sub.dial(ctx)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("error connecting to events: %w", err)
+	}
+
+	go sub.run(ctx, conn)
+
+	return sub, nil
+}
+
+// Events returns the channel receiving live events. It is closed when the subscription ends.
+func (s *EventSubscription) Events() <-chan LiveEvent {
+	return s.events
+}
+
+// Close ends the subscription, closes the connection and waits for the event channel to be closed.
+func (s *EventSubscription) Close() error {
+	s.cancel()
+
+	s.mu.Lock()
+	if s.conn != nil {
+		s.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(wsWriteWait))
+		s.conn.Close()
+	}
+	s.mu.Unlock()
+
+	<-s.done
+	return nil
+}
+
+// dial opens a WebSocket connection to the event endpoint using the client's API key.
+func (s *EventSubscription) dial(ctx context.Context) (*websocket.Conn, error) {
+	c := s.client
+	base, err := c.baseURL(ctx)
+	if err != nil {
+		return nil, err
+	}
+	target := strings.Replace(base, "http", "ws", 1) + c.withDefaultQuery(c.endpoint(OperationConnectEvents))
+
+	header := http.Header{}
+	header.Set("Authorization", fmt.Sprintf("Bearer %s", c.apiKey))
+	header.Set("User-Agent", c.userAgentHeader())
+	if c.locale != "" {
+		header.Set("Accept-Language", c.locale)
+	}
+
+	conn, res, err := c.websocketDialer().DialContext(ctx, target, header)
+	if err != nil {
+		if res != nil {
+			return nil, c.errorFromResponse(res, nil)
+		}
+		return nil, err
+	}
+
+	conn.SetReadDeadline(time.Now().Add(wsPongWait))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(wsPongWait))
+	})
+
+	s.mu.Lock()
+	s.conn = conn
+	s.mu.Unlock()
+
+	return conn, nil
+}
+
+// websocketDialer returns a dialer that connects like the client's HTTP transport: through its dial
+// function, so WithDialTimeout applies, with its TLS configuration and proxy. The handshake is bounded
+// by the TLS handshake and response header timeouts when WithResponseHeaderTimeout is set, and by the
+// gorilla/websocket default otherwise.
+func (c *Client) websocketDialer() *websocket.Dialer {
+	transport := c.transport
+	if transport == nil {
+		transport = http.DefaultTransport.(*http.Transport)
+	}
+
+	dialer := &websocket.Dialer{
+		NetDialContext:   transport.DialContext,
+		Proxy:            transport.Proxy,
+		HandshakeTimeout: websocket.DefaultDialer.HandshakeTimeout,
+	}
+	if transport.TLSClientConfig != nil {
+		dialer.TLSClientConfig = transport.TLSClientConfig.Clone()
+	}
+	if transport.ResponseHeaderTimeout > 0 {
+		dialer.HandshakeTimeout = transport.TLSHandshakeTimeout + transport.ResponseHeaderTimeout
+	}
+
+	return dialer
+}
+
+// run reads events from conn, reconnecting with backoff whenever the connection drops. The
+// subscription's context is cancelled when run returns, whether Close was called or the server
+// ended the subscription.
+func (s *EventSubscription) run(ctx context.Context, conn *websocket.Conn) {
+	defer close(s.done)
+	defer close(s.events)
+	defer s.cancel()
+
+	delay := sseMinReconnectDelay
+	for {
+		s.read(ctx, conn)
+		conn.Close()
+
+		for {
+			if sleep(ctx, delay) != nil {
+				return
+			}
+
+			var err error
+			conn, err = s.dial(ctx)
+			if err == nil {
+				delay = sseMinReconnectDelay
+				break
+			}
+
+			if !isTransientStreamError(err) {
+				return
+			}
+			delay = min(delay*2, sseMaxReconnectDelay)
+		}
+	}
+}
+
+// read delivers events from conn until it fails or ctx is done, sending pings in the background.
+func (s *EventSubscription) read(ctx context.Context, conn *websocket.Conn) {
+	stop := make(chan struct{})
+	defer close(stop)
+
+	go func() {
+		ticker := time.NewTicker(wsPingPeriod)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				s.mu.Lock()
+				err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(wsWriteWait))
+				s.mu.Unlock()
+				if err != nil {
+					conn.Close()
+					return
+				}
+			case <-ctx.Done():
+				conn.Close()
+				return
+			case <-stop:
+				return
+			}
+		}
+	}()
+
+	for {
+		var event LiveEvent
+		if err := conn.ReadJSON(&event); err != nil {
+			var syntaxErr *json.SyntaxError
+			if errors.As(err, &syntaxErr) {
+				continue
+			}
+			return
+		}
+
+		select {
+		case s.events <- event:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
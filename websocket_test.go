@@ -0,0 +1,119 @@
+package client
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestConnectEventsEndsWhenServerRejectsReconnect(t *testing.T) {
+	var connections atomic.Int64
+	upgrader := websocket.Upgrader{}
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer test-key" {
+			t.Errorf("Authorization = %q", r.Header.Get("Authorization"))
+		}
+		if connections.Add(1) > 1 {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		conn.WriteJSON(LiveEvent{Type: "created", Resource: "article", ID: "1"})
+		conn.Close()
+	}))
+
+	sub, err := c.ConnectEvents(context.Background())
+	if err != nil {
+		t.Fatalf("ConnectEvents() error = %v", err)
+	}
+
+	event, ok := <-sub.Events()
+	if !ok || event.ID != "1" {
+		t.Fatalf("first event = %+v, %v, want article 1", event, ok)
+	}
+
+	select {
+	case _, ok := <-sub.Events():
+		if ok {
+			t.Fatal("received an unexpected second event")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("subscription did not end after the reconnect was rejected")
+	}
+	if err := sub.Close(); err != nil {
+		t.Errorf("Close() error = %v", err)
+	}
+}
+
+func TestConnectEventsUsesClientDialer(t *testing.T) {
+	var dials atomic.Int64
+	upgrader := websocket.Upgrader{}
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		conn.ReadMessage()
+	}), WithDialTimeout(time.Second))
+
+	dial := c.transport.DialContext
+	c.transport.DialContext = func(ctx context.Context, network, addr string) (net.Conn, error) {
+		dials.Add(1)
+		return dial(ctx, network, addr)
+	}
+
+	sub, err := c.ConnectEvents(context.Background())
+	if err != nil {
+		t.Fatalf("ConnectEvents() error = %v", err)
+	}
+	defer sub.Close()
+
+	if got := dials.Load(); got != 1 {
+		t.Errorf("client dialer used %d times, want 1", got)
+	}
+}
+
+func TestConnectEventsEndsOnHTMLAuthError(t *testing.T) {
+	var connections atomic.Int64
+	upgrader := websocket.Upgrader{}
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if connections.Add(1) > 1 {
+			w.Header().Set("Content-Type", "text/html")
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte("<h1>403 Forbidden</h1>"))
+			return
+		}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		conn.Close()
+	}))
+
+	sub, err := c.ConnectEvents(context.Background())
+	if err != nil {
+		t.Fatalf("ConnectEvents() error = %v", err)
+	}
+	defer sub.Close()
+
+	select {
+	case _, ok := <-sub.Events():
+		if ok {
+			t.Fatal("received an unexpected event")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("subscription kept reconnecting after the credentials were rejected")
+	}
+	if got := connections.Load(); got != 2 {
+		t.Errorf("opened %d connections, want the rejected reconnect to be the last", got)
+	}
+}